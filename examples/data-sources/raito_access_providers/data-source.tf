@@ -0,0 +1,19 @@
+data "raito_datasource" "snowflake" {
+  name = "Snowflake"
+}
+
+data "raito_access_providers" "snowflake_grants" {
+  action         = "Grant"
+  state          = "Active"
+  data_source    = data.raito_datasource.snowflake.id
+  include_counts = true
+}
+
+check "no_empty_grants" {
+  assert {
+    condition = alltrue([
+      for ap in data.raito_access_providers.snowflake_grants.access_providers : ap.who_count > 0
+    ])
+    error_message = "All active Snowflake grants should have at least one who-item."
+  }
+}
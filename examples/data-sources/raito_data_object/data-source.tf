@@ -0,0 +1,12 @@
+data "raito_datasource" "snowflake" {
+  name = "Snowflake"
+}
+
+data "raito_data_object" "by_name" {
+  data_source = data.raito_datasource.snowflake.id
+  full_name   = "MASTER_DATA.SALES.SPECIALOFFER"
+}
+
+data "raito_data_object" "by_id" {
+  id = data.raito_data_object.by_name.parent
+}
@@ -0,0 +1,40 @@
+data "raito_datasource" "snowflake" {
+  name = "Snowflake"
+}
+
+data "raito_data_object" "sales" {
+  data_source = data.raito_datasource.snowflake.id
+  full_name   = "MASTER_DATA.SALES"
+}
+
+# All tables in the SALES schema
+data "raito_data_objects" "sales_tables" {
+  data_source = data.raito_datasource.snowflake.id
+  parent      = data.raito_data_object.sales.id
+  types       = ["table"]
+}
+
+# All data objects in the data source tagged as PII
+data "raito_data_objects" "pii" {
+  data_source = data.raito_datasource.snowflake.id
+  tag_key     = "pii"
+}
+
+resource "raito_grant" "sales_tables" {
+  for_each = { for obj in data.raito_data_objects.sales_tables.data_objects : obj.full_name => obj }
+
+  name        = "Read ${each.value.name}"
+  description = "Read access to ${each.key}"
+  data_source = [
+    {
+      data_source = data.raito_datasource.snowflake.id
+    }
+  ]
+  what_data_objects = [
+    {
+      fullname    = each.key
+      data_source = data.raito_datasource.snowflake.id
+      permissions = ["SELECT"]
+    }
+  ]
+}
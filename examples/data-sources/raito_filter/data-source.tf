@@ -0,0 +1,8 @@
+data "raito_datasource" "snowflake" {
+  name = "Snowflake"
+}
+
+data "raito_filter" "region" {
+  name        = "Region filter"
+  data_source = data.raito_datasource.snowflake.id
+}
@@ -0,0 +1,23 @@
+data "raito_datasource" "snowflake" {
+  name = "Snowflake"
+}
+
+data "raito_grant" "finance_read" {
+  name        = "Finance read access"
+  data_source = data.raito_datasource.snowflake.id
+}
+
+resource "raito_grant" "reporting" {
+  name        = "Reporting"
+  description = "Inherits all who-items of the finance team grant"
+  data_source = [
+    {
+      data_source = data.raito_datasource.snowflake.id
+    }
+  ]
+  who = [
+    {
+      access_control = data.raito_grant.finance_read.id
+    }
+  ]
+}
@@ -0,0 +1,17 @@
+data "raito_identitystore" "snowflake" {
+  name = "Snowflake"
+}
+
+data "raito_group" "by_name" {
+  name           = "SALES"
+  identity_store = data.raito_identitystore.snowflake.id
+}
+
+data "raito_group" "by_external_id" {
+  external_id = "00g1abcdef"
+}
+
+data "raito_group" "by_external_id_in_identity_store" {
+  external_id    = "00g1abcdef"
+  identity_store = data.raito_identitystore.snowflake.id
+}
@@ -0,0 +1,3 @@
+data "raito_mask" "email" {
+  name = "Email mask"
+}
@@ -0,0 +1,3 @@
+data "raito_purpose" "marketing" {
+  name = "Marketing analytics"
+}
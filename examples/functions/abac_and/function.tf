@@ -0,0 +1,11 @@
+variable "departments" {
+  type    = list(string)
+  default = ["Finance", "Accounting"]
+}
+
+output "department_tables" {
+  value = provider::raito::abac_and(
+    provider::raito::abac_property_equals("type", "table"),
+    provider::raito::abac_or([for department in var.departments : provider::raito::abac_has_tag("department", department)]...),
+  )
+}
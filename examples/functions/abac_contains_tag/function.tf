@@ -0,0 +1,4 @@
+output "pii" {
+  # {"comparison":{"leftOperand":"classification","operator":"ContainsTag","rightOperand":{"literal":{"string":"pii"}}}}
+  value = provider::raito::abac_contains_tag("classification", "pii")
+}
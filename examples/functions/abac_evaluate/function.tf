@@ -0,0 +1,38 @@
+locals {
+  finance_without_pii = jsonencode({
+    aggregator : {
+      operator : "And",
+      operands : [
+        {
+          comparison : {
+            operator : "HasTag",
+            leftOperand : "department",
+            rightOperand : { literal : { string : "Finance" } }
+          }
+        },
+        {
+          unaryExpression : {
+            operator : "Not",
+            expression : {
+              comparison : {
+                operator : "HasTag",
+                leftOperand : "pii",
+                rightOperand : { literal : { string : "true" } }
+              }
+            }
+          }
+        }
+      ]
+    }
+  })
+}
+
+output "matches_finance_table" {
+  # true
+  value = provider::raito::abac_evaluate(local.finance_without_pii, { department = ["Finance"] }, {})
+}
+
+output "matches_pii_table" {
+  # false
+  value = provider::raito::abac_evaluate(local.finance_without_pii, { department = ["Finance"], pii = ["true"] }, {})
+}
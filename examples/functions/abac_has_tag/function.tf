@@ -0,0 +1,4 @@
+output "finance" {
+  # {"aggregator":{"operands":[{"aggregator":{"operands":[{"comparison":{"leftOperand":"department","operator":"HasTag","rightOperand":{"literal":{"string":"Finance"}}}}],"operator":"And"}}],"operator":"Or"}}
+  value = provider::raito::abac_has_tag("department", "Finance")
+}
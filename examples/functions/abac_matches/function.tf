@@ -0,0 +1,16 @@
+locals {
+  finance_without_pii = provider::raito::abac_and(
+    provider::raito::abac_has_tag("department", "Finance"),
+    provider::raito::abac_not(provider::raito::abac_has_tag("pii", "true")),
+  )
+}
+
+output "matches_finance_table" {
+  # true
+  value = provider::raito::abac_matches(local.finance_without_pii, { department = ["Finance"] })
+}
+
+output "matches_pii_table" {
+  # false
+  value = provider::raito::abac_matches(local.finance_without_pii, { department = ["Finance"], pii = ["true"] })
+}
@@ -0,0 +1,3 @@
+output "not_pii" {
+  value = provider::raito::abac_not(provider::raito::abac_contains_tag("classification", "pii"))
+}
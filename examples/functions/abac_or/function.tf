@@ -0,0 +1,6 @@
+output "finance_or_accounting" {
+  value = provider::raito::abac_or(
+    provider::raito::abac_has_tag("department", "Finance"),
+    provider::raito::abac_has_tag("department", "Accounting"),
+  )
+}
@@ -0,0 +1,4 @@
+output "tables" {
+  # {"comparison":{"leftOperand":"type","operator":"PropertyEquals","rightOperand":{"literal":{"string":"table"}}}}
+  value = provider::raito::abac_property_equals("type", "table")
+}
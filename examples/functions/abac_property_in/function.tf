@@ -0,0 +1,4 @@
+output "tables_and_views" {
+  # {"comparison":{"leftOperand":"type","operator":"PropertyIn","rightOperand":{"literal":{"stringList":["table","view"]}}}}
+  value = provider::raito::abac_property_in("type", ["table", "view"])
+}
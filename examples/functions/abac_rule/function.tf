@@ -0,0 +1,11 @@
+resource "raito_grant" "finance_without_pii" {
+  name        = "Finance without PII"
+  data_source = raito_datasource.ds.id
+  what_abac_rule = {
+    # The rule is rewritten to an Or aggregator of And aggregators of (negated) comparisons.
+    rule = provider::raito::abac_rule(provider::raito::abac_and(
+      provider::raito::abac_has_tag("department", "Finance"),
+      provider::raito::abac_not(provider::raito::abac_contains_tag("classification", "pii")),
+    ))
+  }
+}
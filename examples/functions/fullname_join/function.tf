@@ -0,0 +1,14 @@
+variable "tables" {
+  type    = list(string)
+  default = ["SALES", "my.table"]
+}
+
+resource "raito_grant" "tables" {
+  name        = "Sales tables"
+  data_source = raito_datasource.ds.id
+  what_data_objects = [for table in var.tables : {
+    # MASTER_DATA.SALES.SALES and MASTER_DATA.SALES."my.table"
+    fullname    = provider::raito::fullname_join("MASTER_DATA", "SALES", table)
+    data_source = raito_datasource.ds.id
+  }]
+}
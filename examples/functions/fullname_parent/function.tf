@@ -0,0 +1,4 @@
+output "schema" {
+  # MASTER_DATA."my.schema"
+  value = provider::raito::fullname_parent("MASTER_DATA.\"my.schema\".CUSTOMERS")
+}
@@ -0,0 +1,9 @@
+output "quoted" {
+  # "my.schema"
+  value = provider::raito::fullname_quote("my.schema")
+}
+
+output "unquoted" {
+  # CUSTOMERS
+  value = provider::raito::fullname_quote("CUSTOMERS")
+}
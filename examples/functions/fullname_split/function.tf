@@ -0,0 +1,4 @@
+output "parts" {
+  # ["MASTER_DATA", "my.schema", "CUSTOMERS"]
+  value = provider::raito::fullname_split("MASTER_DATA.\"my.schema\".CUSTOMERS")
+}
@@ -24,7 +24,9 @@ locals {
                     operator : "HasTag",
                     leftOperand : "sensitivity",
                     rightOperand : {
-                      string : "PII"
+                      literal : {
+                        string : "PII"
+                      }
                     }
                   }
                 }
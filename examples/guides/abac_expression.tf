@@ -0,0 +1,12 @@
+resource "raito_grant" "example_expression_grant" {
+  name        = "Grant with abac expressions"
+  description = "Grant with what and who abac rules in the abac expression language"
+  state       = "Active"
+  what_abac_rule = {
+    rule_expression = <<-EOT
+      has_tag("department", "Finance") and not has_tag("sensitivity", "PII")
+    EOT
+  }
+  who_abac_expression = "property_in(\"department\", [\"Finance\", \"Accounting\"])"
+  data_source         = raito_datasource.ds.id
+}
@@ -0,0 +1,44 @@
+resource "raito_grant" "example_structured_grant" {
+  name        = "Grant with structured abac"
+  description = "Grant with structured what and who abac rules"
+  state       = "Active"
+  what_abac_rule = {
+    abac = {
+      any_of = [
+        {
+          all_of = [
+            {
+              has_tag = {
+                key   = "department"
+                value = "Finance"
+              }
+            },
+            {
+              not = {
+                has_tag = {
+                  key   = "sensitivity"
+                  value = "PII"
+                }
+              }
+            }
+          ]
+        }
+      ]
+    }
+  }
+  who_abac = {
+    any_of = [
+      {
+        all_of = [
+          {
+            property_in = {
+              property = "department"
+              values   = ["Finance", "Accounting"]
+            }
+          }
+        ]
+      }
+    ]
+  }
+  data_source = raito_datasource.ds.id
+}
@@ -3,4 +3,34 @@ provider "raito" {
   domain = "mycompany"
   user   = "terraform@raito.io"
   secret = "password"
-}
\ No newline at end of file
+}
+
+# Environment-based authentication using RAITO_DOMAIN, RAITO_USER and RAITO_SECRET
+provider "raito" {
+  alias = "environment"
+}
+
+# Profile-based authentication using a profile in ~/.raito/credentials
+provider "raito" {
+  alias   = "profile"
+  profile = "mycompany"
+}
+
+# Retry failed API calls with jittered exponential backoff
+provider "raito" {
+  alias = "retry"
+
+  retry {
+    max_attempts              = 5
+    base_delay                = "1s"
+    max_delay                 = "1m"
+    retryable_status          = ["429", "5xx"]
+    retryable_mutation_status = ["429", "503"]
+  }
+}
+
+# Show the owning workspace on all locks set by Terraform
+provider "raito" {
+  alias       = "lock_reason"
+  lock_reason = "Managed by Terraform in workspace {workspace} ({resource_type} {name})"
+}
@@ -0,0 +1,5 @@
+#Import global role assignment of a user. The ID is composed of the role name and the user ID separated by #
+terraform import raito_global_role_assignment.example "Admin#userId"
+
+#Import global role assignment of a group. The ID is composed of the role name and the group ID prefixed with group: separated by #
+terraform import raito_global_role_assignment.example_group "Observer#group:groupId"
@@ -14,4 +14,18 @@ resource "raito_global_role_assignment" "u1_admin" {
 resource "raito_global_role_assignment" "u1_creator" {
   user = raito_user.u1.id
   role = "Creator"
-}
\ No newline at end of file
+}
+
+data "raito_identitystore" "okta" {
+  name = "Okta"
+}
+
+data "raito_group" "analysts" {
+  name           = "analysts"
+  identity_store = data.raito_identitystore.okta.id
+}
+
+resource "raito_global_role_assignment" "analysts_observer" {
+  group = data.raito_group.analysts.id
+  role  = "Observer"
+}
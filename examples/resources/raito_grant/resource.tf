@@ -13,6 +13,10 @@ resource "raito_grant" "grant1" {
     {
       user : "user2@company.com"
       promise_duration : 604800
+    },
+    {
+      user : "contractor@company.com"
+      expires_at : "2025-12-31T23:59:59Z"
     }
   ]
   type = "role"
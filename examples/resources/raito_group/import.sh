@@ -0,0 +1,2 @@
+#Import group. Note that members will not be managed until they are set in the configuration
+terraform import raito_group.example groupId
@@ -0,0 +1,17 @@
+data "raito_identitystore" "raito" {
+  name = "Raito"
+}
+
+resource "raito_user" "u1" {
+  name       = "user name"
+  email      = "test-user@raito.io"
+  raito_user = false
+}
+
+resource "raito_group" "example" {
+  name           = "data-engineers"
+  display_name   = "Data Engineers"
+  description    = "All data engineers"
+  identity_store = data.raito_identitystore.raito.id
+  members        = [raito_user.u1.id]
+}
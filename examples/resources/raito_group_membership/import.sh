@@ -0,0 +1,2 @@
+#Import group membership. The ID is composed of the identity store ID, the group ID and the member ID separated by #
+terraform import raito_group_membership.example "identityStoreId#groupId#memberId"
@@ -0,0 +1,21 @@
+data "raito_identitystore" "okta" {
+  name = "Okta"
+}
+
+data "raito_group" "engineers" {
+  name           = "engineers"
+  identity_store = data.raito_identitystore.okta.id
+}
+
+resource "raito_user" "service_account" {
+  name       = "dbt service account"
+  email      = "dbt@company.com"
+  type       = "Machine"
+  raito_user = false
+}
+
+resource "raito_group_membership" "service_account" {
+  group          = data.raito_group.engineers.id
+  user           = raito_user.service_account.id
+  identity_store = data.raito_identitystore.okta.id
+}
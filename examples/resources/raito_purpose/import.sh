@@ -0,0 +1,2 @@
+#Import purpose. Note that who will not be imported
+terraform import raito_purpose.example purposeId
\ No newline at end of file
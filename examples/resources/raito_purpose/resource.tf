@@ -0,0 +1,41 @@
+resource "raito_datasource" "ds" {
+  name = "exampleDS"
+}
+
+resource "raito_purpose" "purpose1" {
+  name        = "Purpose1"
+  description = "Customer analytics purpose"
+  state       = "Active"
+  who = [
+    {
+      user : "user1@company.com"
+    },
+    {
+      group : "groupId"
+    }
+  ]
+  what_locked = true
+}
+
+resource "raito_grant" "grant1" {
+  name        = "Purpose grant"
+  description = "Grant inheriting the who-items of Purpose1"
+  state       = "Active"
+  who = [
+    {
+      access_control : raito_purpose.purpose1.id
+    }
+  ]
+  data_source = [
+    {
+      data_source : raito_datasource.ds.id
+      type : "role"
+    }
+  ]
+  what_data_objects = [
+    {
+      fullname : "MASTER_DATA.SALES"
+      data_source : raito_datasource.ds.id
+    }
+  ]
+}
@@ -0,0 +1,2 @@
+#Import role assignment. The ID is composed of the resource type, the resource ID, the role name and the assignee (prefixed with user: or group:) separated by #
+terraform import raito_role_assignment.example "data_source#dataSourceId#Owner#group:groupId"
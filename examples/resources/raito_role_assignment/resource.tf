@@ -0,0 +1,19 @@
+data "raito_identitystore" "okta" {
+  name = "Okta"
+}
+
+data "raito_group" "data_stewards" {
+  name           = "data-stewards"
+  identity_store = data.raito_identitystore.okta.id
+}
+
+data "raito_datasource" "snowflake" {
+  name = "Snowflake"
+}
+
+resource "raito_role_assignment" "snowflake_owner" {
+  role          = "Owner"
+  resource_type = "data_source"
+  resource_id   = data.raito_datasource.snowflake.id
+  group         = data.raito_group.data_stewards.id
+}
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/types/abac_expression"
+)
+
+var _ function.Function = (*AbacEvaluateFunction)(nil)
+
+type AbacEvaluateFunction struct{}
+
+func NewAbacEvaluateFunction() function.Function {
+	return &AbacEvaluateFunction{}
+}
+
+func (f *AbacEvaluateFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "abac_evaluate"
+}
+
+func (f *AbacEvaluateFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Evaluate an abac rule",
+		Description:         "Evaluates the json representation of an abac rule for an object with the given tags and properties, without contacting Raito Cloud.",
+		MarkdownDescription: "Evaluates the json representation of an abac rule for an object with the given tags and properties, without contacting Raito Cloud. `HasTag` matches if one of the values of the tag equals the given value, `ContainsTag` matches if one of the values of the tag contains the given value. Comparisons with a reference to a user attribute never match. See the [abac guide](../guides/abac.md) for the structure of the rule.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "rule",
+				CustomType:          abac_expression.AbacRuleType{},
+				Description:         "The json representation of the abac rule",
+				MarkdownDescription: "The json representation of the abac rule",
+			},
+			function.MapParameter{
+				Name:                "tags",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				Description:         "The tags of the object, mapping each tag key to all values of that key",
+				MarkdownDescription: "The tags of the object, mapping each tag key to all values of that key",
+			},
+			function.MapParameter{
+				Name:                "properties",
+				ElementType:         types.StringType,
+				Description:         "The properties of the object",
+				MarkdownDescription: "The properties of the object",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *AbacEvaluateFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var rule abac_expression.AbacRuleValue
+	var tags map[string][]string
+	var properties map[string]string
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &rule, &tags, &properties))
+
+	if response.Error != nil {
+		return
+	}
+
+	var expression abac_expression.BinaryExpression
+
+	if diagnostics := rule.Unmarshal(&expression); diagnostics.HasError() {
+		response.Error = function.NewArgumentFuncError(0, diagnostics.Errors()[0].Detail())
+
+		return
+	}
+
+	result, err := expression.Evaluate(tags, properties)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, "Invalid abac rule: "+err.Error())
+
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, result))
+}
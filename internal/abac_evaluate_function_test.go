@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/types/abac_expression"
+)
+
+func runAbacEvaluateFunction(rule string, tags map[string][]string) *function.RunResponse {
+	tagValues := map[string]attr.Value{}
+	for key, values := range tags {
+		elements := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+
+		tagValues[key] = types.ListValueMust(types.StringType, elements)
+	}
+
+	response := function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+
+	NewAbacEvaluateFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			abac_expression.NewAbacRuleValue(rule),
+			types.MapValueMust(types.ListType{ElemType: types.StringType}, tagValues),
+			types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		}),
+	}, &response)
+
+	return &response
+}
+
+func TestAbacEvaluateFunction(t *testing.T) {
+	rule := `{"aggregator":{"operator":"And","operands":[
+		{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Finance"}}}},
+		{"unaryExpression":{"operator":"Not","expression":{"comparison":{"operator":"HasTag","leftOperand":"pii","rightOperand":{"literal":{"string":"true"}}}}}}
+	]}}`
+
+	tests := []struct {
+		tags     map[string][]string
+		expected bool
+	}{
+		{tags: map[string][]string{"department": {"Finance"}}, expected: true},
+		{tags: map[string][]string{"department": {"Finance"}, "pii": {"true"}}, expected: false},
+		{tags: map[string][]string{"department": {"Sales"}, "pii": {"false"}}, expected: false},
+	}
+
+	for _, test := range tests {
+		response := runAbacEvaluateFunction(rule, test.tags)
+		if response.Error != nil {
+			t.Fatalf("unexpected error: %v", response.Error)
+		}
+
+		if !response.Result.Value().Equal(types.BoolValue(test.expected)) {
+			t.Errorf("tags %v: expected %t, got %s", test.tags, test.expected, response.Result.Value())
+		}
+	}
+}
+
+func TestAbacEvaluateFunction_InvalidRule(t *testing.T) {
+	response := runAbacEvaluateFunction(`{"aggregator":{"operator":"And","operands":[]}}`, nil)
+
+	if response.Error == nil || response.Error.FunctionArgument == nil || *response.Error.FunctionArgument != 0 {
+		t.Errorf("expected an error on the rule argument, got: %v", response.Error)
+	}
+}
@@ -0,0 +1,789 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	raitoType "github.com/raito-io/sdk-go/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/types/abac_expression"
+	"github.com/raito-io/terraform-provider-raito/internal/utils"
+)
+
+// The structured abac rule is a disjunction (any_of) of conjunctions (all_of) of conditions.
+// This is the shape of abac rules accepted by Raito Cloud, see docs/guides/abac.md.
+
+var abacTagComparisonAttributeTypes = map[string]attr.Type{
+	"key":   types.StringType,
+	"value": types.StringType,
+}
+
+var abacPropertyEqualsAttributeTypes = map[string]attr.Type{
+	"property": types.StringType,
+	"value":    types.StringType,
+}
+
+var abacPropertyInAttributeTypes = map[string]attr.Type{
+	"property": types.StringType,
+	"values":   types.ListType{ElemType: types.StringType},
+}
+
+var abacComparisonAttributeTypes = map[string]attr.Type{
+	"has_tag":         types.ObjectType{AttrTypes: abacTagComparisonAttributeTypes},
+	"contains_tag":    types.ObjectType{AttrTypes: abacTagComparisonAttributeTypes},
+	"property_equals": types.ObjectType{AttrTypes: abacPropertyEqualsAttributeTypes},
+	"property_in":     types.ObjectType{AttrTypes: abacPropertyInAttributeTypes},
+}
+
+var abacConditionAttributeTypes = map[string]attr.Type{
+	"has_tag":         types.ObjectType{AttrTypes: abacTagComparisonAttributeTypes},
+	"contains_tag":    types.ObjectType{AttrTypes: abacTagComparisonAttributeTypes},
+	"property_equals": types.ObjectType{AttrTypes: abacPropertyEqualsAttributeTypes},
+	"property_in":     types.ObjectType{AttrTypes: abacPropertyInAttributeTypes},
+	"not":             types.ObjectType{AttrTypes: abacComparisonAttributeTypes},
+}
+
+var abacAllOfAttributeTypes = map[string]attr.Type{
+	"all_of": types.ListType{ElemType: types.ObjectType{AttrTypes: abacConditionAttributeTypes}},
+}
+
+var abacRuleAttributeTypes = map[string]attr.Type{
+	"any_of": types.ListType{ElemType: types.ObjectType{AttrTypes: abacAllOfAttributeTypes}},
+}
+
+func abacComparisonSchemaAttributes() map[string]schema.Attribute {
+	tagComparison := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"key": schema.StringAttribute{
+					Required:            true,
+					Optional:            false,
+					Computed:            false,
+					Sensitive:           false,
+					Description:         "The key of the tag",
+					MarkdownDescription: "The key of the tag",
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"value": schema.StringAttribute{
+					Required:            true,
+					Optional:            false,
+					Computed:            false,
+					Sensitive:           false,
+					Description:         "The value of the tag",
+					MarkdownDescription: "The value of the tag",
+				},
+			},
+			Required:            false,
+			Optional:            true,
+			Computed:            false,
+			Sensitive:           false,
+			Description:         description,
+			MarkdownDescription: description,
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"has_tag":      tagComparison("Matches if the tag with the given key has the given value"),
+		"contains_tag": tagComparison("Matches if the tag with the given key contains the given value"),
+		"property_equals": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"property": schema.StringAttribute{
+					Required:            true,
+					Optional:            false,
+					Computed:            false,
+					Sensitive:           false,
+					Description:         "The name of the property",
+					MarkdownDescription: "The name of the property",
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"value": schema.StringAttribute{
+					Required:            true,
+					Optional:            false,
+					Computed:            false,
+					Sensitive:           false,
+					Description:         "The expected value of the property",
+					MarkdownDescription: "The expected value of the property",
+				},
+			},
+			Required:            false,
+			Optional:            true,
+			Computed:            false,
+			Sensitive:           false,
+			Description:         "Matches if the property has the given value",
+			MarkdownDescription: "Matches if the property has the given value",
+		},
+		"property_in": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"property": schema.StringAttribute{
+					Required:            true,
+					Optional:            false,
+					Computed:            false,
+					Sensitive:           false,
+					Description:         "The name of the property",
+					MarkdownDescription: "The name of the property",
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"values": schema.ListAttribute{
+					ElementType:         types.StringType,
+					Required:            true,
+					Optional:            false,
+					Computed:            false,
+					Sensitive:           false,
+					Description:         "The allowed values of the property",
+					MarkdownDescription: "The allowed values of the property",
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+			},
+			Required:            false,
+			Optional:            true,
+			Computed:            false,
+			Sensitive:           false,
+			Description:         "Matches if the property has one of the given values",
+			MarkdownDescription: "Matches if the property has one of the given values",
+		},
+	}
+}
+
+// abacRuleSchemaAttribute returns the structured representation of an abac rule.
+func abacRuleSchemaAttribute(description string, markdownDescription string) schema.SingleNestedAttribute {
+	conditionAttributes := abacComparisonSchemaAttributes()
+	conditionAttributes["not"] = schema.SingleNestedAttribute{
+		Attributes:          abacComparisonSchemaAttributes(),
+		Required:            false,
+		Optional:            true,
+		Computed:            false,
+		Sensitive:           false,
+		Description:         "Matches if the nested condition does not match. Exactly one condition must be set.",
+		MarkdownDescription: "Matches if the nested condition does not match. Exactly one of `has_tag`, `contains_tag`, `property_equals` or `property_in` must be set.",
+	}
+
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"any_of": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"all_of": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: conditionAttributes,
+							},
+							Required:            true,
+							Optional:            false,
+							Computed:            false,
+							Sensitive:           false,
+							Description:         "The conditions that all must match. Exactly one condition must be set in each item.",
+							MarkdownDescription: "The conditions that all must match. Exactly one of `has_tag`, `contains_tag`, `property_equals`, `property_in` or `not` must be set in each item.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The groups of conditions of which at least one must match",
+				MarkdownDescription: "The groups of conditions of which at least one must match",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+		Required:            false,
+		Optional:            true,
+		Computed:            false,
+		Sensitive:           false,
+		Description:         description,
+		MarkdownDescription: markdownDescription,
+	}
+}
+
+// abacRuleToBinaryExpression converts a structured abac rule to a binary expression.
+// Errors are reported on the given path. Unknown values result in a nil expression without errors.
+func abacRuleToBinaryExpression(ctx context.Context, rule types.Object, rulePath path.Path) (_ *abac_expression.BinaryExpression, diagnostics diag.Diagnostics) {
+	if rule.IsNull() || rule.IsUnknown() {
+		return nil, diagnostics
+	}
+
+	anyOf, ok := rule.Attributes()["any_of"].(types.List)
+	if !ok || anyOf.IsUnknown() {
+		return nil, diagnostics
+	}
+
+	orOperands := make([]abac_expression.BinaryExpression, 0, len(anyOf.Elements()))
+
+	for i, anyOfItem := range anyOf.Elements() {
+		anyOfPath := rulePath.AtName("any_of").AtListIndex(i)
+
+		anyOfObject, ok := anyOfItem.(types.Object)
+		if !ok || anyOfObject.IsUnknown() {
+			return nil, diagnostics
+		}
+
+		allOf, ok := anyOfObject.Attributes()["all_of"].(types.List)
+		if !ok || allOf.IsUnknown() {
+			return nil, diagnostics
+		}
+
+		andOperands := make([]abac_expression.BinaryExpression, 0, len(allOf.Elements()))
+
+		for j, condition := range allOf.Elements() {
+			conditionObject, ok := condition.(types.Object)
+			if !ok || conditionObject.IsUnknown() {
+				return nil, diagnostics
+			}
+
+			expression, conditionDiagnostics := abacConditionToBinaryExpression(ctx, conditionObject, anyOfPath.AtName("all_of").AtListIndex(j), true)
+			diagnostics.Append(conditionDiagnostics...)
+
+			if expression == nil {
+				continue
+			}
+
+			andOperands = append(andOperands, *expression)
+		}
+
+		orOperands = append(orOperands, abac_expression.BinaryExpression{
+			Aggregator: &abac_expression.Aggregator{
+				Operator: abac_expression.AggregatorOperatorAnd,
+				Operands: andOperands,
+			},
+		})
+	}
+
+	if diagnostics.HasError() || len(orOperands) != len(anyOf.Elements()) {
+		return nil, diagnostics
+	}
+
+	return &abac_expression.BinaryExpression{
+		Aggregator: &abac_expression.Aggregator{
+			Operator: abac_expression.AggregatorOperatorOr,
+			Operands: orOperands,
+		},
+	}, diagnostics
+}
+
+func abacConditionToBinaryExpression(ctx context.Context, condition types.Object, conditionPath path.Path, allowNot bool) (_ *abac_expression.BinaryExpression, diagnostics diag.Diagnostics) {
+	var expression *abac_expression.BinaryExpression
+
+	conditionsFound := 0
+
+	for key, value := range condition.Attributes() {
+		if value.IsNull() {
+			continue
+		}
+
+		conditionsFound++
+
+		if value.IsUnknown() {
+			continue
+		}
+
+		attributes := value.(types.Object).Attributes()
+
+		switch key {
+		case "has_tag":
+			expression = abacComparison(abac_expression.AbacOperatorHasTag, attributes["key"], abac_expression.Literal{String: stringPointer(attributes["value"])})
+		case "contains_tag":
+			expression = abacComparison(abac_expression.AbacOperatorContainsTag, attributes["key"], abac_expression.Literal{String: stringPointer(attributes["value"])})
+		case "property_equals":
+			expression = abacComparison(abac_expression.AbacOperatorPropertyEquals, attributes["property"], abac_expression.Literal{String: stringPointer(attributes["value"])})
+		case "property_in":
+			valueList := attributes["values"].(types.List)
+			if valueList.IsUnknown() {
+				continue
+			}
+
+			values, valueDiagnostics := utils.StringListToSlice(ctx, valueList)
+			diagnostics.Append(valueDiagnostics...)
+
+			expression = abacComparison(abac_expression.AbacOperatorPropertyIn, attributes["property"], abac_expression.Literal{StringList: values})
+		case "not":
+			if !allowNot {
+				diagnostics.AddAttributeError(conditionPath.AtName(key), "Invalid abac condition", "A not condition cannot be nested in another not condition.")
+
+				continue
+			}
+
+			operand, operandDiagnostics := abacConditionToBinaryExpression(ctx, value.(types.Object), conditionPath.AtName(key), false)
+			diagnostics.Append(operandDiagnostics...)
+
+			if operand != nil {
+				expression = &abac_expression.BinaryExpression{
+					UnaryExpression: &abac_expression.UnaryExpression{
+						Operator: abac_expression.UnaryOperatorNot,
+						Operand:  *operand,
+					},
+				}
+			}
+		}
+	}
+
+	if conditionsFound != 1 {
+		diagnostics.AddAttributeError(conditionPath, "Invalid abac condition", fmt.Sprintf("Expected exactly one of has_tag, contains_tag, property_equals, property_in or not to be set, got: %d.", conditionsFound))
+
+		return nil, diagnostics
+	}
+
+	return expression, diagnostics
+}
+
+func abacComparison(operator abac_expression.AbacOperator, leftOperand attr.Value, literal abac_expression.Literal) *abac_expression.BinaryExpression {
+	left := stringPointer(leftOperand)
+	if left == nil {
+		return nil
+	}
+
+	return &abac_expression.BinaryExpression{
+		Comparison: &abac_expression.AbacComparison{
+			Operator:     operator,
+			LeftOperand:  *left,
+			RightOperand: abac_expression.Operand{Literal: &literal},
+		},
+	}
+}
+
+func stringPointer(value attr.Value) *string {
+	stringValue, ok := value.(types.String)
+	if !ok || stringValue.IsUnknown() {
+		return nil
+	}
+
+	return stringValue.ValueStringPointer()
+}
+
+// abacRuleFromBinaryExpression converts a binary expression to a structured abac rule.
+// An error is returned if the expression does not have the shape of a structured abac rule.
+func abacRuleFromBinaryExpression(expression *abac_expression.BinaryExpression) (types.Object, error) {
+	anyOf := abacOperands(expression, abac_expression.AggregatorOperatorOr)
+	anyOfItems := make([]attr.Value, 0, len(anyOf))
+
+	for i := range anyOf {
+		allOf := abacOperands(&anyOf[i], abac_expression.AggregatorOperatorAnd)
+		allOfItems := make([]attr.Value, 0, len(allOf))
+
+		for j := range allOf {
+			condition, err := abacConditionFromBinaryExpression(&allOf[j], true)
+			if err != nil {
+				return types.ObjectNull(abacRuleAttributeTypes), err
+			}
+
+			allOfItems = append(allOfItems, condition)
+		}
+
+		anyOfItems = append(anyOfItems, types.ObjectValueMust(abacAllOfAttributeTypes, map[string]attr.Value{
+			"all_of": types.ListValueMust(types.ObjectType{AttrTypes: abacConditionAttributeTypes}, allOfItems),
+		}))
+	}
+
+	return types.ObjectValueMust(abacRuleAttributeTypes, map[string]attr.Value{
+		"any_of": types.ListValueMust(types.ObjectType{AttrTypes: abacAllOfAttributeTypes}, anyOfItems),
+	}), nil
+}
+
+// abacOperands returns the operands of the expression if it is an aggregator with the given operator. Otherwise, the expression itself is the only operand.
+func abacOperands(expression *abac_expression.BinaryExpression, operator abac_expression.AggregatorOperator) []abac_expression.BinaryExpression {
+	if expression.Aggregator != nil && expression.Aggregator.Operator == operator {
+		return expression.Aggregator.Operands
+	}
+
+	return []abac_expression.BinaryExpression{*expression}
+}
+
+func abacConditionFromBinaryExpression(expression *abac_expression.BinaryExpression, allowNot bool) (types.Object, error) {
+	attributeTypes := abacComparisonAttributeTypes
+	if allowNot {
+		attributeTypes = abacConditionAttributeTypes
+	}
+
+	attributes := make(map[string]attr.Value, len(attributeTypes))
+	for key, attributeType := range attributeTypes {
+		attributes[key] = types.ObjectNull(attributeType.(types.ObjectType).AttrTypes)
+	}
+
+	switch {
+	case expression.UnaryExpression != nil && allowNot && expression.UnaryExpression.Operator == abac_expression.UnaryOperatorNot:
+		operand, err := abacConditionFromBinaryExpression(&expression.UnaryExpression.Operand, false)
+		if err != nil {
+			return types.ObjectNull(attributeTypes), err
+		}
+
+		attributes["not"] = operand
+	case expression.Comparison != nil:
+		key, value, err := abacComparisonFromBinaryExpression(expression.Comparison)
+		if err != nil {
+			return types.ObjectNull(attributeTypes), err
+		}
+
+		attributes[key] = value
+	default:
+		return types.ObjectNull(attributeTypes), fmt.Errorf("abac rule is not a disjunction of conjunctions of (negated) comparisons")
+	}
+
+	return types.ObjectValueMust(attributeTypes, attributes), nil
+}
+
+func abacComparisonFromBinaryExpression(comparison *abac_expression.AbacComparison) (string, types.Object, error) {
+	literal := comparison.RightOperand.Literal
+	if literal == nil {
+		return "", types.Object{}, fmt.Errorf("right operand of %s comparison on %q is not a literal", comparison.Operator, comparison.LeftOperand)
+	}
+
+	switch comparison.Operator {
+	case abac_expression.AbacOperatorHasTag, abac_expression.AbacOperatorContainsTag:
+		key := "has_tag"
+		if comparison.Operator == abac_expression.AbacOperatorContainsTag {
+			key = "contains_tag"
+		}
+
+		return key, types.ObjectValueMust(abacTagComparisonAttributeTypes, map[string]attr.Value{
+			"key":   types.StringValue(comparison.LeftOperand),
+			"value": types.StringPointerValue(literal.String),
+		}), nil
+	case abac_expression.AbacOperatorPropertyEquals:
+		return "property_equals", types.ObjectValueMust(abacPropertyEqualsAttributeTypes, map[string]attr.Value{
+			"property": types.StringValue(comparison.LeftOperand),
+			"value":    types.StringPointerValue(literal.String),
+		}), nil
+	case abac_expression.AbacOperatorPropertyIn:
+		values := make([]attr.Value, 0, len(literal.StringList))
+		for _, value := range literal.StringList {
+			values = append(values, types.StringValue(value))
+		}
+
+		return "property_in", types.ObjectValueMust(abacPropertyInAttributeTypes, map[string]attr.Value{
+			"property": types.StringValue(comparison.LeftOperand),
+			"values":   types.ListValueMust(types.StringType, values),
+		}), nil
+	default:
+		return "", types.Object{}, fmt.Errorf("unsupported abac operator %s", comparison.Operator)
+	}
+}
+
+// abacRuleObjectFromServer converts an abac rule, as returned by Raito Cloud, to a structured abac rule.
+// The prior structured rule is kept if it is equivalent to the rule in Raito Cloud (e.g. only the order of the operands differs).
+func abacRuleObjectFromServer(ctx context.Context, serverRule serverAbacRule, prior types.Object, rulePath path.Path) (_ types.Object, diagnostics diag.Diagnostics) {
+	expression, diagnostics := abacRuleFromServer(serverRule, rulePath)
+	if expression == nil {
+		return types.ObjectNull(abacRuleAttributeTypes), diagnostics
+	}
+
+	// Errors in the prior rule were already reported on plan, so they are ignored here.
+	if priorExpression, _ := abacRuleToBinaryExpression(ctx, prior, rulePath); priorExpression != nil && priorExpression.Equivalent(*expression) {
+		return prior, diagnostics
+	}
+
+	rule, err := abacRuleFromBinaryExpression(expression)
+	if err != nil {
+		diagnostics.AddAttributeError(rulePath, "Abac rule cannot be represented as structured rule", fmt.Sprintf("The abac rule in Raito Cloud cannot be represented in the structured form: %s. Use the json representation instead.", err.Error()))
+
+		return types.ObjectNull(abacRuleAttributeTypes), diagnostics
+	}
+
+	return rule, diagnostics
+}
+
+// whatAbacRuleExpression returns the abac rule of a what_abac_rule attribute. The rule is either defined as json (rule), structured (abac) or in the abac expression language (rule_expression).
+func whatAbacRuleExpression(ctx context.Context, attributes map[string]attr.Value) (_ *abac_expression.BinaryExpression, diagnostics diag.Diagnostics) {
+	if abacRule, found := attributes["abac"]; found && !abacRule.IsNull() {
+		return abacRuleToBinaryExpression(ctx, abacRule.(types.Object), path.Root("what_abac_rule").AtName("abac"))
+	}
+
+	if ruleExpression, found := attributes["rule_expression"].(abac_expression.AbacExpressionValue); found && !ruleExpression.IsNull() {
+		return abacExpressionToBinaryExpression(ruleExpression, path.Root("what_abac_rule").AtName("rule_expression"))
+	}
+
+	jsonRule, ok := attributes["rule"].(abac_expression.AbacRuleValue)
+	if !ok || jsonRule.IsNull() || jsonRule.IsUnknown() {
+		return nil, diagnostics
+	}
+
+	var abacRule abac_expression.BinaryExpression
+	diagnostics.Append(jsonRule.Unmarshal(&abacRule)...)
+
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return &abacRule, diagnostics
+}
+
+// whatAbacRuleFromServer returns the rule, abac and rule_expression attributes of a what_abac_rule read from Raito Cloud.
+// The representation (json, structured or abac expression) of the prior what_abac_rule is kept. The json representation is used on import.
+func whatAbacRuleFromServer(ctx context.Context, prior types.Object, serverRule serverAbacRule) (rule abac_expression.AbacRuleValue, abac types.Object, ruleExpression abac_expression.AbacExpressionValue, diagnostics diag.Diagnostics) {
+	rule = abac_expression.NewAbacRuleNull()
+	abac = types.ObjectNull(abacRuleAttributeTypes)
+	ruleExpression = abac_expression.NewAbacExpressionNull()
+
+	if prior.IsNull() || prior.IsUnknown() {
+		rule, diagnostics = abacRuleValueFromServer(serverRule, rule, path.Root("what_abac_rule").AtName("rule"))
+
+		return rule, abac, ruleExpression, diagnostics
+	}
+
+	attributes := prior.Attributes()
+
+	if priorAbac, found := attributes["abac"].(types.Object); found && !priorAbac.IsNull() {
+		abac, diagnostics = abacRuleObjectFromServer(ctx, serverRule, priorAbac, path.Root("what_abac_rule").AtName("abac"))
+		if abac.IsNull() {
+			abac = priorAbac
+		}
+
+		return rule, abac, ruleExpression, diagnostics
+	}
+
+	if priorRuleExpression, found := attributes["rule_expression"].(abac_expression.AbacExpressionValue); found && !priorRuleExpression.IsNull() {
+		ruleExpression, diagnostics = abacExpressionFromServer(serverRule, path.Root("what_abac_rule").AtName("rule_expression"))
+		if ruleExpression.IsNull() {
+			ruleExpression = priorRuleExpression
+		}
+
+		return rule, abac, ruleExpression, diagnostics
+	}
+
+	if priorRule, found := attributes["rule"].(abac_expression.AbacRuleValue); found {
+		rule = priorRule
+	}
+
+	rule, diagnostics = abacRuleValueFromServer(serverRule, rule, path.Root("what_abac_rule").AtName("rule"))
+
+	return rule, abac, ruleExpression, diagnostics
+}
+
+// abacRuleToGqlInput normalises the abac rule to the form required by Raito Cloud and converts it to its gql input.
+func abacRuleToGqlInput(rule *abac_expression.BinaryExpression) (*raitoType.AbacComparisonExpressionInput, error) {
+	normalizedRule, err := rule.Normalize()
+	if err != nil {
+		return nil, fmt.Errorf("normalize abac rule: %w", err)
+	}
+
+	if errs := normalizedRule.ValidateNormalized(); len(errs) > 0 {
+		return nil, fmt.Errorf("normalized abac rule is invalid: %w", errs)
+	}
+
+	input, err := normalizedRule.ToGqlInput()
+	if err != nil {
+		return nil, fmt.Errorf("abac rule to gql input: %w", err)
+	}
+
+	return input, nil
+}
+
+// validateWhatAbacRule validates that exactly one of rule, abac or rule_expression is set in the what_abac_rule attribute and that the rule is structurally valid.
+func validateWhatAbacRule(ctx context.Context, whatAbacRule types.Object) (diagnostics diag.Diagnostics) {
+	if whatAbacRule.IsNull() || whatAbacRule.IsUnknown() {
+		return diagnostics
+	}
+
+	attributes := whatAbacRule.Attributes()
+
+	rulesDefined := 0
+
+	for _, key := range []string{"rule", "abac", "rule_expression"} {
+		if !attributes[key].IsNull() {
+			rulesDefined++
+		}
+	}
+
+	if rulesDefined != 1 {
+		diagnostics.AddAttributeError(path.Root("what_abac_rule"), "Invalid what_abac_rule", "Exactly one of rule, abac or rule_expression must be set in what_abac_rule.")
+
+		return diagnostics
+	}
+
+	expression, abacDiagnostics := whatAbacRuleExpression(ctx, attributes)
+	diagnostics.Append(abacDiagnostics...)
+
+	if expression != nil && !attributes["rule"].IsNull() {
+		diagnostics.Append(abacRuleValidationDiagnostics(expression, path.Root("what_abac_rule").AtName("rule"))...)
+	}
+
+	return diagnostics
+}
+
+// validateAbacRuleJson validates the structure of an abac rule defined as json.
+func validateAbacRuleJson(rule abac_expression.AbacRuleValue, rulePath path.Path) (diagnostics diag.Diagnostics) {
+	if rule.IsNull() || rule.IsUnknown() {
+		return diagnostics
+	}
+
+	var abacRule abac_expression.BinaryExpression
+	diagnostics.Append(rule.Unmarshal(&abacRule)...)
+
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	diagnostics.Append(abacRuleValidationDiagnostics(&abacRule, rulePath)...)
+
+	return diagnostics
+}
+
+// validateAbacExpression validates an abac rule written in the abac expression language.
+// Syntax errors are reported by the attribute type, see abac_expression.AbacExpressionValue.
+func validateAbacExpression(ruleExpression abac_expression.AbacExpressionValue, rulePath path.Path) (diagnostics diag.Diagnostics) {
+	if ruleExpression.IsNull() || ruleExpression.IsUnknown() {
+		return diagnostics
+	}
+
+	abacRule, err := ruleExpression.Parse()
+	if err != nil {
+		return diagnostics
+	}
+
+	return abacRuleValidationDiagnostics(abacRule, rulePath)
+}
+
+// abacExpressionToBinaryExpression parses and validates an abac rule written in the abac expression language. Nil is returned if the rule is unknown.
+func abacExpressionToBinaryExpression(ruleExpression abac_expression.AbacExpressionValue, rulePath path.Path) (_ *abac_expression.BinaryExpression, diagnostics diag.Diagnostics) {
+	if ruleExpression.IsUnknown() {
+		return nil, diagnostics
+	}
+
+	abacRule, err := ruleExpression.Parse()
+	if err != nil {
+		diagnostics.AddAttributeError(rulePath, "Invalid abac expression", fmt.Sprintf("Syntax error in abac expression at %s", err.Error()))
+
+		return nil, diagnostics
+	}
+
+	diagnostics.Append(abacRuleValidationDiagnostics(abacRule, rulePath)...)
+
+	return abacRule, diagnostics
+}
+
+// abacExpressionFromServer converts an abac rule, as returned by Raito Cloud, to the abac expression language.
+func abacExpressionFromServer(serverRule serverAbacRule, rulePath path.Path) (_ abac_expression.AbacExpressionValue, diagnostics diag.Diagnostics) {
+	expression, diagnostics := abacRuleFromServer(serverRule, rulePath)
+	if expression == nil {
+		return abac_expression.NewAbacExpressionNull(), diagnostics
+	}
+
+	return abac_expression.NewAbacExpressionValue(expression.ToDsl()), diagnostics
+}
+
+// abacRuleValueFromServer converts an abac rule, as returned by Raito Cloud, to its canonical json representation.
+// The prior value is kept if Raito Cloud did not return the rule.
+func abacRuleValueFromServer(serverRule serverAbacRule, prior abac_expression.AbacRuleValue, rulePath path.Path) (_ abac_expression.AbacRuleValue, diagnostics diag.Diagnostics) {
+	expression, diagnostics := abacRuleFromServer(serverRule, rulePath)
+	if expression == nil {
+		return prior, diagnostics
+	}
+
+	rule, err := abac_expression.NewAbacRuleExpressionValue(expression)
+	if err != nil {
+		diagnostics.AddAttributeError(rulePath, "Invalid abac rule", err.Error())
+
+		return prior, diagnostics
+	}
+
+	return rule, diagnostics
+}
+
+// accessProviderMutationDiagnostics reports a failed create or update of an access provider.
+// If the abac rules of the access provider compare with attributes of the user, the comparisons are named,
+// so it is clear which comparisons to change if Raito Cloud does not accept references.
+func accessProviderMutationDiagnostics(summary string, input *raitoType.AccessProviderInput, err error) (diagnostics diag.Diagnostics) {
+	var references []string
+
+	if input.WhoAbacRule != nil {
+		for _, reference := range abac_expression.ReferencesInGqlInput(&input.WhoAbacRule.Rule) {
+			references = append(references, fmt.Sprintf("%s (who abac rule)", reference))
+		}
+	}
+
+	if input.WhatAbacRule != nil {
+		for _, reference := range abac_expression.ReferencesInGqlInput(&input.WhatAbacRule.Rule) {
+			references = append(references, fmt.Sprintf("%s (what_abac_rule)", reference))
+		}
+	}
+
+	if len(references) == 0 {
+		diagnostics.AddError(summary, err.Error())
+
+		return diagnostics
+	}
+
+	message := strings.ToLower(err.Error())
+	if strings.Contains(message, "reference") || strings.Contains(message, "userattribute") {
+		diagnostics.AddError("Abac rule references not accepted by Raito Cloud", fmt.Sprintf("Raito Cloud did not accept the references to user attributes in the abac rules of the access provider: %s. Compare with literal values instead. Error returned by Raito Cloud: %s", strings.Join(references, ", "), err.Error()))
+
+		return diagnostics
+	}
+
+	diagnostics.AddError(summary, fmt.Sprintf("%s\n\nThe abac rules of the access provider compare with attributes of the user: %s. If the error is caused by these references, compare with literal values instead.", err.Error(), strings.Join(references, ", ")))
+
+	return diagnostics
+}
+
+// serverAbacRule is an abac rule as returned by Raito Cloud.
+// RuleJson is only used if Raito Cloud did not return the structured Rule.
+type serverAbacRule struct {
+	Rule     *raitoType.AbacComparisonExpression
+	RuleJson *string
+}
+
+// abacRuleFromServer builds an abac rule as returned by Raito Cloud.
+// Nil is returned, with a warning, if Raito Cloud did not return the rule.
+func abacRuleFromServer(serverRule serverAbacRule, rulePath path.Path) (_ *abac_expression.BinaryExpression, diagnostics diag.Diagnostics) {
+	expression, err := abac_expression.FromServer(serverRule.Rule, serverRule.RuleJson)
+	if errors.Is(err, abac_expression.ErrRuleMissing) {
+		diagnostics.AddAttributeWarning(rulePath, "Abac rule not returned by Raito Cloud", "Raito Cloud did not return the abac rule of the access provider. The abac rule in the state is kept, or left empty on import.")
+
+		return nil, diagnostics
+	} else if err != nil {
+		diagnostics.AddAttributeError(rulePath, "Invalid abac rule returned by Raito Cloud", err.Error())
+
+		return nil, diagnostics
+	}
+
+	return expression, diagnostics
+}
+
+// abacRuleValidationDiagnostics reports each structural error of the abac rule as an error on the attribute at rulePath.
+func abacRuleValidationDiagnostics(rule *abac_expression.BinaryExpression, rulePath path.Path) (diagnostics diag.Diagnostics) {
+	for _, err := range rule.Validate() {
+		location := "the root of the rule"
+		if err.Path != "" {
+			location = err.Path
+		}
+
+		diagnostics.AddAttributeError(rulePath, "Invalid abac rule", fmt.Sprintf("Invalid abac rule at %s: %s.", location, err.Message))
+	}
+
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	normalizedRule, err := rule.Normalize()
+	if err != nil {
+		diagnostics.AddAttributeError(rulePath, "Invalid abac rule", fmt.Sprintf("Invalid abac rule: %s.", err.Error()))
+
+		return diagnostics
+	}
+
+	// Raito Cloud only accepts an Or of Ands of (negated) conditions.
+	for _, err := range normalizedRule.ValidateNormalized() {
+		diagnostics.AddAttributeError(rulePath, "Invalid abac rule", fmt.Sprintf("The normalized abac rule is not accepted by Raito Cloud: %s. Please report this issue to the provider developers.", err.Error()))
+	}
+
+	return diagnostics
+}
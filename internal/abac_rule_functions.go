@@ -0,0 +1,420 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/types/abac_expression"
+)
+
+var (
+	_ function.Function = (*AbacComparisonFunction)(nil)
+	_ function.Function = (*AbacAggregatorFunction)(nil)
+	_ function.Function = (*AbacNotFunction)(nil)
+	_ function.Function = (*AbacRuleFunction)(nil)
+	_ function.Function = (*AbacMatchesFunction)(nil)
+)
+
+// AbacComparisonFunction builds the json representation of an abac rule with a single comparison, e.g. abac_has_tag.
+type AbacComparisonFunction struct {
+	name            string
+	operator        abac_expression.AbacOperator
+	leftOperandName string
+	description     string
+}
+
+func NewAbacHasTagFunction() function.Function {
+	return &AbacComparisonFunction{
+		name:            "abac_has_tag",
+		operator:        abac_expression.AbacOperatorHasTag,
+		leftOperandName: "key",
+		description:     "Returns an abac rule that matches data objects with a tag with the given key and value.",
+	}
+}
+
+func NewAbacContainsTagFunction() function.Function {
+	return &AbacComparisonFunction{
+		name:            "abac_contains_tag",
+		operator:        abac_expression.AbacOperatorContainsTag,
+		leftOperandName: "key",
+		description:     "Returns an abac rule that matches data objects with a tag with the given key of which the value contains the given value.",
+	}
+}
+
+func NewAbacPropertyEqualsFunction() function.Function {
+	return &AbacComparisonFunction{
+		name:            "abac_property_equals",
+		operator:        abac_expression.AbacOperatorPropertyEquals,
+		leftOperandName: "property",
+		description:     "Returns an abac rule that matches data objects of which the property equals the given value.",
+	}
+}
+
+func NewAbacPropertyInFunction() function.Function {
+	return &AbacComparisonFunction{
+		name:            "abac_property_in",
+		operator:        abac_expression.AbacOperatorPropertyIn,
+		leftOperandName: "property",
+		description:     "Returns an abac rule that matches data objects of which the property equals one of the given values.",
+	}
+}
+
+func (f *AbacComparisonFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = f.name
+}
+
+func (f *AbacComparisonFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	var valueParameter function.Parameter = function.StringParameter{
+		Name:                "value",
+		Description:         "The value to compare with",
+		MarkdownDescription: "The value to compare with",
+	}
+
+	if f.operator == abac_expression.AbacOperatorPropertyIn {
+		valueParameter = function.ListParameter{
+			Name:                "values",
+			ElementType:         types.StringType,
+			Description:         "The values to compare with",
+			MarkdownDescription: "The values to compare with",
+		}
+	}
+
+	response.Definition = function.Definition{
+		Summary:             fmt.Sprintf("Build a %s abac rule", f.operator),
+		Description:         f.description,
+		MarkdownDescription: fmt.Sprintf("%s The normalised json representation of the rule is returned, which can be combined with other abac rules or used as abac rule of an access provider.", f.description),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                f.leftOperandName,
+				Description:         fmt.Sprintf("The %s of the data object to compare", f.leftOperandName),
+				MarkdownDescription: fmt.Sprintf("The %s of the data object to compare", f.leftOperandName),
+			},
+			valueParameter,
+		},
+		Return: function.StringReturn{
+			CustomType: abac_expression.AbacRuleType{},
+		},
+	}
+}
+
+func (f *AbacComparisonFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var leftOperand string
+	var literal abac_expression.Literal
+
+	if f.operator == abac_expression.AbacOperatorPropertyIn {
+		var values []string
+		response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &leftOperand, &values))
+
+		literal.StringList = append([]string{}, values...)
+	} else {
+		var value string
+		response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &leftOperand, &value))
+
+		literal.String = &value
+	}
+
+	if response.Error != nil {
+		return
+	}
+
+	if leftOperand == "" {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The %s should not be empty", f.leftOperandName))
+
+		return
+	}
+
+	setAbacRuleResult(ctx, response, &abac_expression.BinaryExpression{
+		Comparison: &abac_expression.AbacComparison{
+			Operator:     f.operator,
+			LeftOperand:  leftOperand,
+			RightOperand: abac_expression.Operand{Literal: &literal},
+		},
+	})
+}
+
+// AbacAggregatorFunction combines abac rules with an And or Or aggregator.
+type AbacAggregatorFunction struct {
+	operator abac_expression.AggregatorOperator
+}
+
+func NewAbacAndFunction() function.Function {
+	return &AbacAggregatorFunction{operator: abac_expression.AggregatorOperatorAnd}
+}
+
+func NewAbacOrFunction() function.Function {
+	return &AbacAggregatorFunction{operator: abac_expression.AggregatorOperatorOr}
+}
+
+func (f *AbacAggregatorFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	if f.operator == abac_expression.AggregatorOperatorAnd {
+		response.Name = "abac_and"
+	} else {
+		response.Name = "abac_or"
+	}
+}
+
+func (f *AbacAggregatorFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	description := "Returns an abac rule that matches if all given abac rules match."
+	if f.operator == abac_expression.AggregatorOperatorOr {
+		description = "Returns an abac rule that matches if at least one of the given abac rules matches."
+	}
+
+	response.Definition = function.Definition{
+		Summary:             fmt.Sprintf("Combine abac rules with %s", f.operator),
+		Description:         description,
+		MarkdownDescription: description + " At least one rule should be given. The normalised json representation of the rule is returned.",
+		VariadicParameter: function.StringParameter{
+			Name:                "rules",
+			CustomType:          abac_expression.AbacRuleType{},
+			Description:         "The json representation of the abac rules to combine",
+			MarkdownDescription: "The json representation of the abac rules to combine",
+		},
+		Return: function.StringReturn{
+			CustomType: abac_expression.AbacRuleType{},
+		},
+	}
+}
+
+func (f *AbacAggregatorFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var rules []string
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &rules))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(rules) == 0 {
+		response.Error = function.NewArgumentFuncError(0, "At least one abac rule is required")
+
+		return
+	}
+
+	operands := make([]abac_expression.BinaryExpression, 0, len(rules))
+
+	for i, rule := range rules {
+		operand, funcErr := abacRuleArgument(abac_expression.NewAbacRuleValue(rule), int64(i))
+		if funcErr != nil {
+			response.Error = funcErr
+
+			return
+		}
+
+		operands = append(operands, *operand)
+	}
+
+	setAbacRuleResult(ctx, response, &abac_expression.BinaryExpression{
+		Aggregator: &abac_expression.Aggregator{
+			Operator: f.operator,
+			Operands: operands,
+		},
+	})
+}
+
+// AbacNotFunction negates an abac rule.
+type AbacNotFunction struct{}
+
+func NewAbacNotFunction() function.Function {
+	return &AbacNotFunction{}
+}
+
+func (f *AbacNotFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "abac_not"
+}
+
+func (f *AbacNotFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Negate an abac rule",
+		Description:         "Returns an abac rule that matches if the given abac rule does not match.",
+		MarkdownDescription: "Returns an abac rule that matches if the given abac rule does not match. The normalised json representation of the rule is returned.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "rule",
+				CustomType:          abac_expression.AbacRuleType{},
+				Description:         "The json representation of the abac rule to negate",
+				MarkdownDescription: "The json representation of the abac rule to negate",
+			},
+		},
+		Return: function.StringReturn{
+			CustomType: abac_expression.AbacRuleType{},
+		},
+	}
+}
+
+func (f *AbacNotFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var rule abac_expression.AbacRuleValue
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &rule))
+
+	if response.Error != nil {
+		return
+	}
+
+	operand, funcErr := abacRuleArgument(rule, 0)
+	if funcErr != nil {
+		response.Error = funcErr
+
+		return
+	}
+
+	setAbacRuleResult(ctx, response, &abac_expression.BinaryExpression{
+		UnaryExpression: &abac_expression.UnaryExpression{
+			Operator: abac_expression.UnaryOperatorNot,
+			Operand:  *operand,
+		},
+	})
+}
+
+// AbacRuleFunction validates an abac rule and returns it in the normalised form accepted by Raito Cloud.
+type AbacRuleFunction struct{}
+
+func NewAbacRuleFunction() function.Function {
+	return &AbacRuleFunction{}
+}
+
+func (f *AbacRuleFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "abac_rule"
+}
+
+func (f *AbacRuleFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Normalise an abac rule",
+		Description:         "Validates the abac rule and returns it in the normalised form accepted by Raito Cloud: an Or aggregator of And aggregators of (negated) comparisons.",
+		MarkdownDescription: "Validates the abac rule and returns it in the normalised form accepted by Raito Cloud: an `Or` aggregator of `And` aggregators of (negated) comparisons. See the [abac guide](../guides/abac.md) for the normalisation of rules.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "rule",
+				CustomType:          abac_expression.AbacRuleType{},
+				Description:         "The json representation of the abac rule",
+				MarkdownDescription: "The json representation of the abac rule",
+			},
+		},
+		Return: function.StringReturn{
+			CustomType: abac_expression.AbacRuleType{},
+		},
+	}
+}
+
+func (f *AbacRuleFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var rule abac_expression.AbacRuleValue
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &rule))
+
+	if response.Error != nil {
+		return
+	}
+
+	expression, funcErr := abacRuleArgument(rule, 0)
+	if funcErr != nil {
+		response.Error = funcErr
+
+		return
+	}
+
+	normalized, err := expression.Normalize()
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, "Invalid abac rule: "+err.Error())
+
+		return
+	}
+
+	setAbacRuleResult(ctx, response, normalized)
+}
+
+// AbacMatchesFunction evaluates an abac rule for the tags of a data object.
+type AbacMatchesFunction struct{}
+
+func NewAbacMatchesFunction() function.Function {
+	return &AbacMatchesFunction{}
+}
+
+func (f *AbacMatchesFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "abac_matches"
+}
+
+func (f *AbacMatchesFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Check if an abac rule matches tags",
+		Description:         "Evaluates the abac rule for a data object with the given tags, without contacting Raito Cloud. Comparisons on properties never match, use abac_evaluate to evaluate them.",
+		MarkdownDescription: "Evaluates the abac rule for a data object with the given tags, without contacting Raito Cloud. Comparisons on properties never match, use [`abac_evaluate`](./abac_evaluate.md) to evaluate them.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "rule",
+				CustomType:          abac_expression.AbacRuleType{},
+				Description:         "The json representation of the abac rule",
+				MarkdownDescription: "The json representation of the abac rule",
+			},
+			function.MapParameter{
+				Name:                "tags",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				Description:         "The tags of the data object, mapping each tag key to all values of that key",
+				MarkdownDescription: "The tags of the data object, mapping each tag key to all values of that key",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *AbacMatchesFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var rule abac_expression.AbacRuleValue
+	var tags map[string][]string
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &rule, &tags))
+
+	if response.Error != nil {
+		return
+	}
+
+	expression, funcErr := abacRuleArgument(rule, 0)
+	if funcErr != nil {
+		response.Error = funcErr
+
+		return
+	}
+
+	result, err := expression.Evaluate(tags, nil)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, "Invalid abac rule: "+err.Error())
+
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, result))
+}
+
+// abacRuleArgument parses and validates the abac rule passed as argument to a function.
+func abacRuleArgument(rule abac_expression.AbacRuleValue, argument int64) (*abac_expression.BinaryExpression, *function.FuncError) {
+	var expression abac_expression.BinaryExpression
+
+	if diagnostics := rule.Unmarshal(&expression); diagnostics.HasError() {
+		return nil, function.NewArgumentFuncError(argument, diagnostics.Errors()[0].Detail())
+	}
+
+	if errs := expression.Validate(); errs != nil {
+		return nil, function.NewArgumentFuncError(argument, "Invalid abac rule: "+errs.Error())
+	}
+
+	return &expression, nil
+}
+
+// setAbacRuleResult sets the json representation of the normalised abac rule as result of a function.
+func setAbacRuleResult(ctx context.Context, response *function.RunResponse, expression *abac_expression.BinaryExpression) {
+	normalized, err := expression.Normalize()
+	if err != nil {
+		response.Error = function.ConcatFuncErrors(response.Error, function.NewFuncError("Invalid abac rule: "+err.Error()))
+
+		return
+	}
+
+	rule, err := abac_expression.NewAbacRuleExpressionValue(normalized)
+	if err != nil {
+		response.Error = function.ConcatFuncErrors(response.Error, function.NewFuncError(err.Error()))
+
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, rule))
+}
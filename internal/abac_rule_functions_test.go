@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/types/abac_expression"
+)
+
+func runAbacRuleFunction(t *testing.T, f function.Function, arguments ...attr.Value) string {
+	t.Helper()
+
+	response := function.RunResponse{Result: function.NewResultData(abac_expression.NewAbacRuleUnknown())}
+
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, &response)
+
+	if response.Error != nil {
+		t.Fatalf("unexpected error: %v", response.Error)
+	}
+
+	return response.Result.Value().(abac_expression.AbacRuleValue).ValueString()
+}
+
+func abacRuleTuple(rules ...string) types.Tuple {
+	elementTypes := make([]attr.Type, 0, len(rules))
+	elements := make([]attr.Value, 0, len(rules))
+
+	for _, rule := range rules {
+		elementTypes = append(elementTypes, abac_expression.AbacRuleType{})
+		elements = append(elements, abac_expression.NewAbacRuleValue(rule))
+	}
+
+	return types.TupleValueMust(elementTypes, elements)
+}
+
+func TestAbacRuleFunctions(t *testing.T) {
+	hasTag := runAbacRuleFunction(t, NewAbacHasTagFunction(), types.StringValue("department"), types.StringValue("Finance"))
+	if expected := `{"aggregator":{"operands":[{"aggregator":{"operands":[{"comparison":{"leftOperand":"department","operator":"HasTag","rightOperand":{"literal":{"string":"Finance"}}}}],"operator":"And"}}],"operator":"Or"}}`; hasTag != expected {
+		t.Fatalf("unexpected abac_has_tag result\n got: %s\nwant: %s", hasTag, expected)
+	}
+
+	propertyIn := runAbacRuleFunction(t, NewAbacPropertyInFunction(), types.StringValue("type"), types.ListValueMust(types.StringType, []attr.Value{types.StringValue("table"), types.StringValue("view")}))
+	pii := runAbacRuleFunction(t, NewAbacContainsTagFunction(), types.StringValue("classification"), types.StringValue("pii"))
+
+	notPii := runAbacRuleFunction(t, NewAbacNotFunction(), abac_expression.NewAbacRuleValue(pii))
+	and := runAbacRuleFunction(t, NewAbacAndFunction(), abacRuleTuple(hasTag, notPii))
+	or := runAbacRuleFunction(t, NewAbacOrFunction(), abacRuleTuple(and, propertyIn))
+
+	rule := runAbacRuleFunction(t, NewAbacRuleFunction(), abac_expression.NewAbacRuleValue(or))
+
+	var expression abac_expression.BinaryExpression
+	if diagnostics := abac_expression.NewAbacRuleValue(rule).Unmarshal(&expression); diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+
+	if errs := expression.ValidateNormalized(); errs != nil {
+		t.Errorf("abac_rule result is not normalised: %v", errs)
+	}
+
+	expected := `has_tag("department", "Finance") and not contains_tag("classification", "pii") or property_in("type", ["table", "view"])`
+	if dsl := expression.ToDsl(); dsl != expected {
+		t.Errorf("unexpected rule\n got: %s\nwant: %s", dsl, expected)
+	}
+}
+
+func TestAbacAndFunction_InvalidRule(t *testing.T) {
+	response := function.RunResponse{Result: function.NewResultData(abac_expression.NewAbacRuleUnknown())}
+
+	NewAbacAndFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{abacRuleTuple(`{"literal":true}`, `{"aggregator":{"operator":"Or","operands":[]}}`)}),
+	}, &response)
+
+	if response.Error == nil || response.Error.FunctionArgument == nil || *response.Error.FunctionArgument != 1 {
+		t.Errorf("expected an error for the second rule, got %v", response.Error)
+	}
+}
+
+func TestAbacMatchesFunction(t *testing.T) {
+	rule := `{"unaryExpression":{"operator":"Not","expression":{"comparison":{"operator":"HasTag","leftOperand":"pii","rightOperand":{"literal":{"string":"true"}}}}}}`
+
+	for _, test := range []struct {
+		tags     []attr.Value
+		expected bool
+	}{
+		{tags: []attr.Value{types.StringValue("false")}, expected: true},
+		{tags: []attr.Value{types.StringValue("false"), types.StringValue("true")}, expected: false},
+	} {
+		response := function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+
+		NewAbacMatchesFunction().Run(context.Background(), function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{
+				abac_expression.NewAbacRuleValue(rule),
+				types.MapValueMust(types.ListType{ElemType: types.StringType}, map[string]attr.Value{"pii": types.ListValueMust(types.StringType, test.tags)}),
+			}),
+		}, &response)
+
+		if response.Error != nil {
+			t.Fatalf("unexpected error: %v", response.Error)
+		}
+
+		if !response.Result.Value().Equal(types.BoolValue(test.expected)) {
+			t.Errorf("tags %v: expected %t, got %s", test.tags, test.expected, response.Result.Value())
+		}
+	}
+}
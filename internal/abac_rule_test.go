@@ -0,0 +1,290 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	raitoType "github.com/raito-io/sdk-go/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/types/abac_expression"
+	"github.com/raito-io/terraform-provider-raito/internal/utils"
+)
+
+func testAbacCondition(key string, value types.Object) types.Object {
+	attributes := map[string]attr.Value{}
+	for attributeKey, attributeType := range abacConditionAttributeTypes {
+		attributes[attributeKey] = types.ObjectNull(attributeType.(types.ObjectType).AttrTypes)
+	}
+
+	if key != "" {
+		attributes[key] = value
+	}
+
+	return types.ObjectValueMust(abacConditionAttributeTypes, attributes)
+}
+
+func testAbacRule(allOf ...[]attr.Value) types.Object {
+	anyOf := make([]attr.Value, 0, len(allOf))
+	for _, conditions := range allOf {
+		anyOf = append(anyOf, types.ObjectValueMust(abacAllOfAttributeTypes, map[string]attr.Value{
+			"all_of": types.ListValueMust(types.ObjectType{AttrTypes: abacConditionAttributeTypes}, conditions),
+		}))
+	}
+
+	return types.ObjectValueMust(abacRuleAttributeTypes, map[string]attr.Value{
+		"any_of": types.ListValueMust(types.ObjectType{AttrTypes: abacAllOfAttributeTypes}, anyOf),
+	})
+}
+
+func TestAbacRule_RoundTrip(t *testing.T) {
+	notCondition := types.ObjectValueMust(abacComparisonAttributeTypes, map[string]attr.Value{
+		"has_tag":      types.ObjectNull(abacTagComparisonAttributeTypes),
+		"contains_tag": types.ObjectNull(abacTagComparisonAttributeTypes),
+		"property_equals": types.ObjectValueMust(abacPropertyEqualsAttributeTypes, map[string]attr.Value{
+			"property": types.StringValue("country"),
+			"value":    types.StringValue("BE"),
+		}),
+		"property_in": types.ObjectNull(abacPropertyInAttributeTypes),
+	})
+
+	rule := testAbacRule(
+		[]attr.Value{
+			testAbacCondition("has_tag", types.ObjectValueMust(abacTagComparisonAttributeTypes, map[string]attr.Value{
+				"key":   types.StringValue("department"),
+				"value": types.StringValue("Finance"),
+			})),
+			testAbacCondition("not", notCondition),
+		},
+		[]attr.Value{
+			testAbacCondition("property_in", types.ObjectValueMust(abacPropertyInAttributeTypes, map[string]attr.Value{
+				"property": types.StringValue("type"),
+				"values":   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("table"), types.StringValue("view")}),
+			})),
+		},
+	)
+
+	expression, diagnostics := abacRuleToBinaryExpression(context.Background(), rule, path.Root("who_abac"))
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", diagnostics)
+	}
+
+	ruleJson, err := json.Marshal(expression)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedJson := `{"aggregator":{"operator":"Or","operands":[{"aggregator":{"operator":"And","operands":[{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Finance"}}}},{"unaryExpression":{"operator":"Not","expression":{"comparison":{"operator":"PropertyEquals","leftOperand":"country","rightOperand":{"literal":{"string":"BE"}}}}}}]}},{"aggregator":{"operator":"And","operands":[{"comparison":{"operator":"PropertyIn","leftOperand":"type","rightOperand":{"literal":{"stringList":["table","view"]}}}}]}}]}}`
+	if string(ruleJson) != expectedJson {
+		t.Errorf("unexpected json\n got: %s\nwant: %s", ruleJson, expectedJson)
+	}
+
+	ruleJsonString := string(ruleJson)
+
+	readRule, diagnostics := abacRuleObjectFromServer(context.Background(), serverAbacRule{RuleJson: &ruleJsonString}, types.ObjectNull(abacRuleAttributeTypes), path.Root("who_abac"))
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", diagnostics)
+	}
+
+	if !readRule.Equal(rule) {
+		t.Errorf("round trip changed the rule\n got: %s\nwant: %s", readRule, rule)
+	}
+}
+
+func TestAbacRule_InvalidCondition(t *testing.T) {
+	rule := testAbacRule([]attr.Value{testAbacCondition("", types.Object{})})
+
+	_, diagnostics := abacRuleToBinaryExpression(context.Background(), rule, path.Root("who_abac"))
+	if !diagnostics.HasError() {
+		t.Fatal("expected an error for a condition without comparison")
+	}
+
+	if got := diagnostics.Errors()[0].(interface{ Path() path.Path }).Path(); !got.Equal(path.Root("who_abac").AtName("any_of").AtListIndex(0).AtName("all_of").AtListIndex(0)) {
+		t.Errorf("unexpected error path %s", got)
+	}
+}
+
+func TestAbacRuleObjectFromServer_KeepsEquivalentPrior(t *testing.T) {
+	hasTag := func(key string, value string) types.Object {
+		return testAbacCondition("has_tag", types.ObjectValueMust(abacTagComparisonAttributeTypes, map[string]attr.Value{
+			"key":   types.StringValue(key),
+			"value": types.StringValue(value),
+		}))
+	}
+
+	prior := testAbacRule(
+		[]attr.Value{hasTag("department", "Finance"), hasTag("country", "BE")},
+		[]attr.Value{hasTag("department", "Sales")},
+	)
+
+	// Same rule as the prior one, with the operands in a different order.
+	reordered := `{"aggregator":{"operator":"Or","operands":[{"aggregator":{"operator":"And","operands":[{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Sales"}}}}]}},{"aggregator":{"operator":"And","operands":[{"comparison":{"operator":"HasTag","leftOperand":"country","rightOperand":{"literal":{"string":"BE"}}}},{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Finance"}}}}]}}]}}`
+
+	readRule, diagnostics := abacRuleObjectFromServer(context.Background(), serverAbacRule{RuleJson: &reordered}, prior, path.Root("who_abac"))
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", diagnostics)
+	}
+
+	if !readRule.Equal(prior) {
+		t.Errorf("expected the prior rule to be kept\n got: %s\nwant: %s", readRule, prior)
+	}
+
+	changed := `{"aggregator":{"operator":"Or","operands":[{"aggregator":{"operator":"And","operands":[{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Sales"}}}}]}}]}}`
+
+	readRule, diagnostics = abacRuleObjectFromServer(context.Background(), serverAbacRule{RuleJson: &changed}, prior, path.Root("who_abac"))
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", diagnostics)
+	}
+
+	if expected := testAbacRule([]attr.Value{hasTag("department", "Sales")}); !readRule.Equal(expected) {
+		t.Errorf("expected the rule of Raito Cloud\n got: %s\nwant: %s", readRule, expected)
+	}
+}
+
+func TestAbacRuleObjectFromServer_Unsupported(t *testing.T) {
+	literalRule := `{"literal":true}`
+
+	_, diagnostics := abacRuleObjectFromServer(context.Background(), serverAbacRule{RuleJson: &literalRule}, types.ObjectNull(abacRuleAttributeTypes), path.Root("who_abac"))
+
+	if !diagnostics.HasError() || !strings.Contains(diagnostics.Errors()[0].Detail(), "cannot be represented") {
+		t.Errorf("expected an error for a literal rule, got %v", diagnostics)
+	}
+}
+
+func TestAbacExpressionFromServer(t *testing.T) {
+	ruleJson := `{"aggregator":{"operator":"Or","operands":[{"aggregator":{"operator":"And","operands":[{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Finance"}}}}]}},{"comparison":{"operator":"PropertyIn","leftOperand":"owner","rightOperand":{"literal":{"stringList":["a","b"]}}}}]}}`
+
+	expression, diagnostics := abacExpressionFromServer(serverAbacRule{RuleJson: &ruleJson}, path.Root("who_abac_expression"))
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+
+	expected := `has_tag("department", "Finance") or property_in("owner", ["a", "b"])`
+	if expression.ValueString() != expected {
+		t.Errorf("unexpected expression\n got: %s\nwant: %s", expression.ValueString(), expected)
+	}
+
+	if expression, diagnostics = abacExpressionFromServer(serverAbacRule{}, path.Root("who_abac_expression")); !expression.IsNull() || diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a null expression and a warning for a missing rule, got %s, %v", expression, diagnostics)
+	}
+}
+
+func TestAbacExpressionToBinaryExpression_SyntaxError(t *testing.T) {
+	_, diagnostics := abacExpressionToBinaryExpression(abac_expression.NewAbacExpressionValue(`has_tag("a", "b") &&`), path.Root("what_abac_rule").AtName("rule_expression"))
+
+	if !diagnostics.HasError() || !strings.Contains(diagnostics.Errors()[0].Detail(), "at 1:19: unexpected character '&'") {
+		t.Errorf("expected a syntax error, got %v", diagnostics)
+	}
+}
+
+func TestAbacRuleValidationDiagnostics_Reference(t *testing.T) {
+	rule, err := abac_expression.ParseDsl(`has_tag("department", user.department)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// References are validated by Raito Cloud, so they are passed on without errors.
+	if diagnostics := abacRuleValidationDiagnostics(rule, path.Root("who_abac_expression")); diagnostics.HasError() {
+		t.Errorf("unexpected errors for a reference to a user attribute: %v", diagnostics)
+	}
+
+	input, err := rule.ToGqlInput()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if reference := input.Comparison.RightOperand.Reference; reference == nil || reference.UserAttribute != "department" {
+		t.Errorf("expected a reference to the department user attribute, got %+v", input.Comparison.RightOperand)
+	}
+}
+
+func TestAccessProviderMutationDiagnostics(t *testing.T) {
+	rule, err := abac_expression.ParseDsl(`has_tag("department", user.department)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ruleInput, err := rule.ToGqlInput()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := raitoType.AccessProviderInput{WhoAbacRule: &raitoType.WhoAbacRuleInput{Rule: *ruleInput}}
+
+	diagnostics := accessProviderMutationDiagnostics("Failed to create access provider", &input, errors.New(`input: unknown field "reference"`))
+	if !diagnostics.HasError() || diagnostics.Errors()[0].Summary() != "Abac rule references not accepted by Raito Cloud" || !strings.Contains(diagnostics.Errors()[0].Detail(), `has_tag("department", user.department) (who abac rule)`) {
+		t.Errorf("expected a diagnostic naming the reference, got %v", diagnostics)
+	}
+
+	diagnostics = accessProviderMutationDiagnostics("Failed to create access provider", &input, errors.New("internal server error"))
+	if !diagnostics.HasError() || diagnostics.Errors()[0].Summary() != "Failed to create access provider" || !strings.Contains(diagnostics.Errors()[0].Detail(), `has_tag("department", user.department)`) {
+		t.Errorf("expected the original error to name the reference, got %v", diagnostics)
+	}
+
+	diagnostics = accessProviderMutationDiagnostics("Failed to create access provider", &raitoType.AccessProviderInput{}, errors.New("internal server error"))
+	if !diagnostics.HasError() || diagnostics.Errors()[0].Detail() != "internal server error" {
+		t.Errorf("expected the original error, got %v", diagnostics)
+	}
+}
+
+func TestReadWhoAbacRule_Import(t *testing.T) {
+	serverRule := serverAbacRule{
+		Rule: &raitoType.AbacComparisonExpression{
+			Comparison: &raitoType.AbacComparisonExpressionComparison{
+				Operator:    "HasTag",
+				LeftOperand: "department",
+				RightOperand: raitoType.AbacComparisonExpressionOperand{
+					Literal: &raitoType.AbacComparisonExpressionLiteral{String: utils.Ptr("Finance")},
+				},
+			},
+		},
+	}
+
+	apModel := AccessProviderResourceModel{}
+
+	diagnostics := readWhoAbacRule(context.Background(), &apModel, serverRule, true)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", diagnostics)
+	}
+
+	expected := `{"comparison":{"leftOperand":"department","operator":"HasTag","rightOperand":{"literal":{"string":"Finance"}}}}`
+	if apModel.WhoAbacRule.ValueString() != expected {
+		t.Errorf("unexpected who_abac_rule\n got: %s\nwant: %s", apModel.WhoAbacRule.ValueString(), expected)
+	}
+
+	if !apModel.WhoAbac.IsNull() || !apModel.WhoAbacExpression.IsNull() {
+		t.Errorf("expected only who_abac_rule to be set on import")
+	}
+
+	// Access providers of which the who is not managed by Terraform keep all who attributes unset.
+	apModel = AccessProviderResourceModel{}
+
+	diagnostics = readWhoAbacRule(context.Background(), &apModel, serverRule, false)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", diagnostics)
+	}
+
+	if !apModel.WhoAbacRule.IsNull() || !apModel.WhoAbac.IsNull() || !apModel.WhoAbacExpression.IsNull() {
+		t.Errorf("expected no who attributes to be set if the who is not managed")
+	}
+}
+
+func TestIsImported(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+
+	if imported, diagnostics := isImported(ctx, private); imported || diagnostics.HasError() {
+		t.Errorf("isImported() on empty private state = %t, %v", imported, diagnostics)
+	}
+
+	private.SetKey(ctx, importedKey, []byte("true"))
+
+	if imported, diagnostics := isImported(ctx, private); !imported || diagnostics.HasError() {
+		t.Errorf("isImported() after import = %t, %v", imported, diagnostics)
+	}
+}
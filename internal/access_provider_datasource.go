@@ -0,0 +1,655 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/raito-io/golang-set/set"
+	"github.com/raito-io/sdk-go"
+	"github.com/raito-io/sdk-go/services"
+	raitoType "github.com/raito-io/sdk-go/types"
+	"github.com/raito-io/sdk-go/types/models"
+
+	"github.com/raito-io/terraform-provider-raito/internal/types/abac_expression"
+)
+
+var (
+	_ datasource.DataSource = (*GrantDataSource)(nil)
+	_ datasource.DataSource = (*MaskDataSource)(nil)
+	_ datasource.DataSource = (*FilterDataSource)(nil)
+	_ datasource.DataSource = (*AccessProviderDataSource[AccessProviderDataSourceModel, *AccessProviderDataSourceModel])(nil)
+)
+
+// AccessProviderDataSourceModel contains the attributes that are returned for every access provider type.
+type AccessProviderDataSourceModel struct {
+	Id              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	Category        types.String   `tfsdk:"category"`
+	DataSource      types.String   `tfsdk:"data_source"`
+	Description     types.String   `tfsdk:"description"`
+	State           types.String   `tfsdk:"state"`
+	Action          types.String   `tfsdk:"action"`
+	Owners          types.Set      `tfsdk:"owners"`
+	Who             types.Set      `tfsdk:"who"`
+	WhatDataObjects types.Set      `tfsdk:"what_data_objects"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *AccessProviderDataSourceModel) GetAccessProviderDataSourceModel() *AccessProviderDataSourceModel {
+	return m
+}
+
+// FromAccessProvider sets the attributes that are specific to the access provider type. Purposes have none.
+func (m *AccessProviderDataSourceModel) FromAccessProvider(_ context.Context, _ *sdk.RaitoClient, _ *raitoType.AccessProvider) diag.Diagnostics {
+	return nil
+}
+
+type AccessProviderDataSourceTypeModel[T any] interface {
+	*T
+	GetAccessProviderDataSourceModel() *AccessProviderDataSourceModel
+	FromAccessProvider(ctx context.Context, client *sdk.RaitoClient, ap *raitoType.AccessProvider) diag.Diagnostics
+}
+
+// AccessProviderDataSource looks up a single existing access provider of a given action by name.
+type AccessProviderDataSource[T any, ApModel AccessProviderDataSourceTypeModel[T]] struct {
+	client *sdk.RaitoClient
+
+	// typeName is the name of the access provider type as used in the schema (e.g. grant) and the type name of the data source.
+	typeName string
+	action   models.AccessProviderAction
+}
+
+func NewPurposeDataSource() datasource.DataSource {
+	return &AccessProviderDataSource[AccessProviderDataSourceModel, *AccessProviderDataSourceModel]{typeName: "purpose", action: models.AccessProviderActionPurpose}
+}
+
+func (a *AccessProviderDataSource[T, ApModel]) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_" + a.typeName
+}
+
+func (a *AccessProviderDataSource[T, ApModel]) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = a.dataSourceSchema(ctx, a.schema())
+}
+
+// schema returns the attributes that are returned for every access provider type.
+func (a *AccessProviderDataSource[T, ApModel]) schema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Required:            false,
+			Optional:            false,
+			Computed:            true,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The ID of the %s", a.typeName),
+			MarkdownDescription: fmt.Sprintf("The ID of the %s", a.typeName),
+		},
+		"name": schema.StringAttribute{
+			Required:            true,
+			Optional:            false,
+			Computed:            false,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The name of the %s", a.typeName),
+			MarkdownDescription: fmt.Sprintf("The name of the %s", a.typeName),
+		},
+		"category": schema.StringAttribute{
+			Required:            false,
+			Optional:            true,
+			Computed:            true,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The ID of the category of the %s. If set, only %ss in this category are considered.", a.typeName, a.typeName),
+			MarkdownDescription: fmt.Sprintf("The ID of the category of the %s. If set, only %ss in this category are considered.", a.typeName, a.typeName),
+		},
+		"data_source": schema.StringAttribute{
+			Required:            false,
+			Optional:            true,
+			Computed:            false,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The ID of a data source. If set, only %ss linked to this data source are considered.", a.typeName),
+			MarkdownDescription: fmt.Sprintf("The ID of a data source. If set, only %ss linked to this data source are considered.", a.typeName),
+		},
+		"description": schema.StringAttribute{
+			Required:            false,
+			Optional:            false,
+			Computed:            true,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The description of the %s", a.typeName),
+			MarkdownDescription: fmt.Sprintf("The description of the %s", a.typeName),
+		},
+		"state": schema.StringAttribute{
+			Required:            false,
+			Optional:            false,
+			Computed:            true,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The state of the %s", a.typeName),
+			MarkdownDescription: fmt.Sprintf("The state of the %s. Possible values are: [%q, %q]", a.typeName, models.AccessProviderStateActive.String(), models.AccessProviderStateInactive.String()),
+		},
+		"action": schema.StringAttribute{
+			Required:            false,
+			Optional:            false,
+			Computed:            true,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The action of the %s", a.typeName),
+			MarkdownDescription: fmt.Sprintf("The action of the %s", a.typeName),
+		},
+		"owners": schema.SetAttribute{
+			ElementType:         types.StringType,
+			Required:            false,
+			Optional:            false,
+			Computed:            true,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The IDs of the owners of the %s", a.typeName),
+			MarkdownDescription: fmt.Sprintf("The IDs of the owners of the %s", a.typeName),
+		},
+		"who": schema.SetNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"user": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The email address of the user",
+						MarkdownDescription: "The email address of the user",
+					},
+					"group": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The ID of the group in Raito Cloud",
+						MarkdownDescription: "The ID of the group in Raito Cloud",
+					},
+					"access_control": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The ID of the access control in Raito Cloud",
+						MarkdownDescription: "The ID of the access control in Raito Cloud",
+					},
+					"promise_duration": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The number of seconds that access is granted when requested, if the who-item is a promise",
+						MarkdownDescription: "The number of seconds that access is granted when requested, if the who-item is a promise",
+					},
+					"expires_at": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The RFC3339 timestamp at which the who-item expires",
+						MarkdownDescription: "The RFC3339 timestamp at which the who-item expires",
+					},
+					"expires_after": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The duration after which the who-item expires",
+						MarkdownDescription: "The duration after which the who-item expires",
+					},
+				},
+			},
+			Required:            false,
+			Optional:            false,
+			Computed:            true,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The who-items associated with the %s", a.typeName),
+			MarkdownDescription: fmt.Sprintf("The who-items associated with the %s", a.typeName),
+		},
+		"what_data_objects": schema.SetNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"fullname": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The full name of the data object",
+						MarkdownDescription: "The full name of the data object",
+					},
+					"data_source": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The ID of the data source of the data object",
+						MarkdownDescription: "The ID of the data source of the data object",
+					},
+					"permissions": schema.SetAttribute{
+						ElementType:         types.StringType,
+						Computed:            true,
+						Description:         "The permissions granted on the data object",
+						MarkdownDescription: "The permissions granted on the data object",
+					},
+					"global_permissions": schema.SetAttribute{
+						ElementType:         types.StringType,
+						Computed:            true,
+						Description:         "The global permissions granted on the data object",
+						MarkdownDescription: "The global permissions granted on the data object",
+					},
+				},
+			},
+			Required:            false,
+			Optional:            false,
+			Computed:            true,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The data object what-items associated with the %s", a.typeName),
+			MarkdownDescription: fmt.Sprintf("The data object what-items associated with the %s", a.typeName),
+		},
+	}
+}
+
+func (a *AccessProviderDataSource[T, ApModel]) dataSourceSchema(ctx context.Context, attributes map[string]schema.Attribute) schema.Schema {
+	return schema.Schema{
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": dataSourceTimeoutsBlock(ctx),
+		},
+		Description:         fmt.Sprintf("Find an existing %s by name", a.typeName),
+		MarkdownDescription: fmt.Sprintf("Find an existing Raito %s by name, optionally restricted to a category or data source. Fails if more than one %s matches.", a.typeName, a.typeName),
+	}
+}
+
+func (a *AccessProviderDataSource[T, ApModel]) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var model T
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+
+	data := ApModel(&model).GetAccessProviderDataSourceModel()
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diagnostics := data.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
+	name := data.Name.ValueString()
+
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	apChannel := a.client.AccessProvider().ListAccessProviders(cancelCtx, services.WithAccessProviderListFilter(&raitoType.AccessProviderFilterInput{
+		Search: &name,
+		Action: []models.AccessProviderAction{a.action},
+	}))
+
+	var matches []*raitoType.AccessProvider
+
+	for apItem := range apChannel {
+		if apItem.HasError() {
+			response.Diagnostics.AddError(fmt.Sprintf("Failed to list %ss", a.typeName), apItem.GetError().Error())
+
+			return
+		}
+
+		ap := apItem.GetItem()
+
+		if ap.Name != name || ap.Action != a.action || ap.State == models.AccessProviderStateDeleted {
+			continue
+		}
+
+		if !data.Category.IsNull() && ap.Category.Id != data.Category.ValueString() {
+			continue
+		}
+
+		if !data.DataSource.IsNull() && !accessProviderHasDataSource(ap, data.DataSource.ValueString()) {
+			continue
+		}
+
+		matches = append(matches, ap)
+	}
+
+	if len(matches) == 0 {
+		response.Diagnostics.AddError(fmt.Sprintf("No %s found", a.typeName), fmt.Sprintf("No %s with name %q matches the given criteria.", a.typeName, name))
+
+		return
+	} else if len(matches) > 1 {
+		ids := make([]string, 0, len(matches))
+		for _, ap := range matches {
+			ids = append(ids, ap.Id)
+		}
+
+		response.Diagnostics.AddError(fmt.Sprintf("Multiple %ss found", a.typeName), fmt.Sprintf("%d %ss with name %q match the given criteria (%s). Set category or data_source to select a single %s.", len(matches), a.typeName, name, strings.Join(ids, ", "), a.typeName))
+
+		return
+	}
+
+	ap := matches[0]
+
+	apModel := AccessProviderResourceModel{}
+	response.Diagnostics.Append(apModel.FromAccessProvider(ap)...)
+
+	owners, ownerDiagnostics := readAccessProviderOwners(ctx, a.client, ap.Id)
+	response.Diagnostics.Append(ownerDiagnostics...)
+
+	whoItems, _, whoDiagnostics := readAccessProviderWhoItems(ctx, a.client, ap.Id, set.Set[string]{}, map[string]types.Object{}, nil)
+	response.Diagnostics.Append(whoDiagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	who, whoDiagnostics := types.SetValue(types.ObjectType{AttrTypes: whoItemAttributeTypes}, whoItems)
+	response.Diagnostics.Append(whoDiagnostics...)
+
+	whatDataObjects, whatDiagnostics := readWhatDataObjects(ctx, a.client, ap.Id)
+	response.Diagnostics.Append(whatDiagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = apModel.Id
+	data.Name = apModel.Name
+	data.Description = apModel.Description
+	data.State = apModel.State
+	data.Action = types.StringValue(ap.Action.String())
+	data.Owners = owners
+	data.Who = who
+	data.WhatDataObjects = whatDataObjects
+
+	if ap.Category.Id != "" {
+		data.Category = types.StringValue(ap.Category.Id)
+	} else {
+		data.Category = types.StringNull()
+	}
+
+	response.Diagnostics.Append(ApModel(&model).FromAccessProvider(ctx, a.client, ap)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+}
+
+func (a *AccessProviderDataSource[T, ApModel]) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.RaitoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.RaitoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *sdk.RaitoClient, not to be nil.",
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// accessProviderHasDataSource returns true if the access provider is linked to the given data source.
+func accessProviderHasDataSource(ap *raitoType.AccessProvider, dataSourceId string) bool {
+	for i := range ap.SyncData {
+		if ap.SyncData[i].DataSource.Id == dataSourceId {
+			return true
+		}
+	}
+
+	return false
+}
+
+type GrantDataSourceModel struct {
+	AccessProviderDataSourceModel
+
+	WhatAbacRule types.Object `tfsdk:"what_abac_rule"`
+}
+
+var grantDataSourceWhatAbacRuleAttributeTypes = map[string]attr.Type{
+	"do_types":           types.SetType{ElemType: types.StringType},
+	"permissions":        types.SetType{ElemType: types.StringType},
+	"global_permissions": types.SetType{ElemType: types.StringType},
+	"scope":              types.SetType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{"data_source": types.StringType, "fullname": types.StringType}}},
+	"rule":               abac_expression.AbacRuleType{},
+}
+
+func (m *GrantDataSourceModel) FromAccessProvider(ctx context.Context, client *sdk.RaitoClient, ap *raitoType.AccessProvider) (diagnostics diag.Diagnostics) {
+	m.WhatAbacRule = types.ObjectNull(grantDataSourceWhatAbacRuleAttributeTypes)
+
+	if ap.WhatType != raitoType.WhoAndWhatTypeDynamic || ap.WhatAbacRule == nil {
+		return diagnostics
+	}
+
+	grant := GrantResourceModel{WhatAbacRule: types.ObjectNull(nil)}
+
+	whatAbacRule, whatAbacDiagnostics := grant.abacWhatFromAccessProvider(ctx, client, ap)
+	diagnostics.Append(whatAbacDiagnostics...)
+
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	m.WhatAbacRule, whatAbacDiagnostics = objectWithAttributes(whatAbacRule, grantDataSourceWhatAbacRuleAttributeTypes)
+	diagnostics.Append(whatAbacDiagnostics...)
+
+	return diagnostics
+}
+
+type GrantDataSource struct {
+	AccessProviderDataSource[GrantDataSourceModel, *GrantDataSourceModel]
+}
+
+func NewGrantDataSource() datasource.DataSource {
+	return &GrantDataSource{
+		AccessProviderDataSource: AccessProviderDataSource[GrantDataSourceModel, *GrantDataSourceModel]{typeName: "grant", action: models.AccessProviderActionGrant},
+	}
+}
+
+func (g *GrantDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	attributes := g.schema()
+	attributes["what_abac_rule"] = schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"fullname": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The full name of the data object in the data source",
+							MarkdownDescription: "The full name of the data object in the data source",
+						},
+						"data_source": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The data source of the data object",
+							MarkdownDescription: "The data source of the data object",
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "Scope of the abac rule",
+				MarkdownDescription: "Scope of the abac rule",
+			},
+			"do_types": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "Set of data object types associated to the abac rule",
+				MarkdownDescription: "Set of data object types associated to the abac rule",
+			},
+			"permissions": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "Set of permissions granted on the data objects matching the abac rule",
+				MarkdownDescription: "Set of permissions granted on the data objects matching the abac rule",
+			},
+			"global_permissions": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "Set of global permissions granted on the data objects matching the abac rule",
+				MarkdownDescription: "Set of global permissions granted on the data objects matching the abac rule",
+			},
+			"rule": whatAbacRuleDataSourceRuleAttribute(),
+		},
+		Computed:            true,
+		Description:         "The abac rule that defines the what-items of the grant, if the grant uses an abac rule",
+		MarkdownDescription: "The abac rule that defines the what-items of the grant, if the grant uses an abac rule. The data objects currently matching the rule are returned in `what_data_objects`.",
+	}
+
+	response.Schema = g.dataSourceSchema(ctx, attributes)
+}
+
+type MaskDataSourceModel struct {
+	AccessProviderDataSourceModel
+
+	Columns      types.Set    `tfsdk:"columns"`
+	WhatAbacRule types.Object `tfsdk:"what_abac_rule"`
+}
+
+var maskDataSourceWhatAbacRuleAttributeTypes = map[string]attr.Type{
+	"scope": types.SetType{ElemType: types.StringType},
+	"rule":  abac_expression.AbacRuleType{},
+}
+
+func (m *MaskDataSourceModel) FromAccessProvider(ctx context.Context, client *sdk.RaitoClient, ap *raitoType.AccessProvider) (diagnostics diag.Diagnostics) {
+	m.Columns = types.SetNull(types.StringType)
+	m.WhatAbacRule = types.ObjectNull(maskDataSourceWhatAbacRuleAttributeTypes)
+
+	if ap.WhatType != raitoType.WhoAndWhatTypeDynamic || ap.WhatAbacRule == nil {
+		m.Columns, diagnostics = types.SetValueFrom(ctx, types.StringType, whatDataObjectFullnames(m.WhatDataObjects))
+
+		return diagnostics
+	}
+
+	mask := MaskResourceModel{WhatAbacRule: types.ObjectNull(nil)}
+
+	whatAbacRule, whatAbacDiagnostics := mask.abacWhatFromAccessProvider(ctx, client, ap)
+	diagnostics.Append(whatAbacDiagnostics...)
+
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	m.WhatAbacRule, whatAbacDiagnostics = objectWithAttributes(whatAbacRule, maskDataSourceWhatAbacRuleAttributeTypes)
+	diagnostics.Append(whatAbacDiagnostics...)
+
+	return diagnostics
+}
+
+type MaskDataSource struct {
+	AccessProviderDataSource[MaskDataSourceModel, *MaskDataSourceModel]
+}
+
+func NewMaskDataSource() datasource.DataSource {
+	return &MaskDataSource{
+		AccessProviderDataSource: AccessProviderDataSource[MaskDataSourceModel, *MaskDataSourceModel]{typeName: "mask", action: models.AccessProviderActionMask},
+	}
+}
+
+func (m *MaskDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	attributes := m.schema()
+	attributes["columns"] = schema.SetAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		Description:         "The full names of the columns that are masked, if the mask does not use an abac rule",
+		MarkdownDescription: "The full names of the columns that are masked, if the mask does not use an abac rule",
+	}
+	attributes["what_abac_rule"] = schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "Scope of the abac rule",
+				MarkdownDescription: "Scope of the abac rule",
+			},
+			"rule": whatAbacRuleDataSourceRuleAttribute(),
+		},
+		Computed:            true,
+		Description:         "The abac rule that defines the columns of the mask, if the mask uses an abac rule",
+		MarkdownDescription: "The abac rule that defines the columns of the mask, if the mask uses an abac rule",
+	}
+
+	response.Schema = m.dataSourceSchema(ctx, attributes)
+}
+
+type FilterDataSourceModel struct {
+	AccessProviderDataSourceModel
+
+	Table        types.String `tfsdk:"table"`
+	FilterPolicy types.String `tfsdk:"filter_policy"`
+}
+
+func (f *FilterDataSourceModel) FromAccessProvider(_ context.Context, _ *sdk.RaitoClient, ap *raitoType.AccessProvider) (diagnostics diag.Diagnostics) {
+	f.FilterPolicy = types.StringPointerValue(ap.PolicyRule)
+	f.Table = types.StringNull()
+
+	tables := whatDataObjectFullnames(f.WhatDataObjects)
+
+	if len(tables) > 1 {
+		diagnostics.AddError("Received multiple tables. Expect exactly one", "Filter data source only supports one table")
+
+		return diagnostics
+	}
+
+	if len(tables) == 1 {
+		f.Table = types.StringValue(tables[0])
+	}
+
+	return diagnostics
+}
+
+type FilterDataSource struct {
+	AccessProviderDataSource[FilterDataSourceModel, *FilterDataSourceModel]
+}
+
+func NewFilterDataSource() datasource.DataSource {
+	return &FilterDataSource{
+		AccessProviderDataSource: AccessProviderDataSource[FilterDataSourceModel, *FilterDataSourceModel]{typeName: "filter", action: models.AccessProviderActionFiltered},
+	}
+}
+
+func (f *FilterDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	attributes := f.schema()
+	attributes["table"] = schema.StringAttribute{
+		Computed:            true,
+		Description:         "The full name of the table that is filtered",
+		MarkdownDescription: "The full name of the table that is filtered",
+	}
+	attributes["filter_policy"] = schema.StringAttribute{
+		Computed:            true,
+		Description:         "The filter policy that defines how the data is filtered",
+		MarkdownDescription: "The filter policy that defines how the data is filtered",
+	}
+
+	response.Schema = f.dataSourceSchema(ctx, attributes)
+}
+
+func whatAbacRuleDataSourceRuleAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType:          abac_expression.AbacRuleType{},
+		Computed:            true,
+		Description:         "json representation of the abac rule. The structured abac and rule_expression representations are not returned, as they are only known for rules that are managed by Terraform.",
+		MarkdownDescription: "json representation of the abac rule. The structured `abac` and `rule_expression` representations are not returned, as they are only known for rules that are managed by Terraform.",
+	}
+}
+
+// objectWithAttributes returns the object restricted to the given attribute types.
+func objectWithAttributes(object types.Object, attributeTypes map[string]attr.Type) (types.Object, diag.Diagnostics) {
+	if object.IsNull() {
+		return types.ObjectNull(attributeTypes), nil
+	}
+
+	attributes := object.Attributes()
+	values := make(map[string]attr.Value, len(attributeTypes))
+
+	for name := range attributeTypes {
+		values[name] = attributes[name]
+	}
+
+	return types.ObjectValue(attributeTypes, values)
+}
+
+// whatDataObjectFullnames returns the full names of the data objects in the what_data_objects set.
+func whatDataObjectFullnames(whatDataObjects types.Set) []string {
+	fullnames := make([]string, 0, len(whatDataObjects.Elements()))
+
+	for _, element := range whatDataObjects.Elements() {
+		fullnames = append(fullnames, element.(types.Object).Attributes()["fullname"].(types.String).ValueString())
+	}
+
+	return fullnames
+}
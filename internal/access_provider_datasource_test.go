@@ -0,0 +1,81 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+)
+
+func TestAccGrantDataSource(t *testing.T) {
+	testId := gonanoid.Must(8)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: false,
+		PreCheck: func() {
+			AccProviderPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_0_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "raito_datasource" "ds" {
+	name = "Snowflake"
+}
+
+resource "raito_grant" "test" {
+	name        = "tfTestGrantLookup-%[1]s"
+	description = "grant lookup test"
+	data_source = [
+		{
+			data_source = data.raito_datasource.ds.id
+		}
+	]
+	what_data_objects = [
+		{
+			fullname = "MASTER_DATA.SALES"
+			data_source = data.raito_datasource.ds.id
+		}
+	]
+	who = [
+		{
+			"user": "terraform@raito.io"
+		}
+	]
+}
+
+data "raito_grant" "test" {
+	name = raito_grant.test.name
+	data_source = data.raito_datasource.ds.id
+}
+`, testId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.raito_grant.test", "id", "raito_grant.test", "id"),
+					resource.TestCheckResourceAttrPair("data.raito_grant.test", "category", "raito_grant.test", "category"),
+					resource.TestCheckResourceAttr("data.raito_grant.test", "description", "grant lookup test"),
+					resource.TestCheckResourceAttr("data.raito_grant.test", "state", "Active"),
+					resource.TestCheckResourceAttr("data.raito_grant.test", "action", "Grant"),
+					resource.TestCheckResourceAttr("data.raito_grant.test", "who.#", "1"),
+					resource.TestCheckResourceAttr("data.raito_grant.test", "who.0.user", "terraform@raito.io"),
+					resource.TestCheckResourceAttr("data.raito_grant.test", "what_data_objects.#", "1"),
+					resource.TestCheckResourceAttr("data.raito_grant.test", "what_data_objects.0.fullname", "MASTER_DATA.SALES"),
+					resource.TestCheckNoResourceAttr("data.raito_grant.test", "what_abac_rule"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "raito_grant" "missing" {
+	name = "tfTestGrantLookup-missing-%[1]s"
+}
+`, testId),
+				ExpectError: regexp.MustCompile(`No grant found`),
+			},
+		},
+	})
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"regexp"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -32,21 +35,23 @@ import (
 	"github.com/raito-io/terraform-provider-raito/internal/utils"
 )
 
-const (
-	lockMsg = "Locked by terraform"
-)
-
 type AccessProviderResourceModel struct {
 	Id                types.String
 	Name              types.String
 	Description       types.String
 	State             types.String
 	Who               types.Set
-	WhoAbacRule       jsontypes.Normalized
+	WhoAbacRule       abac_expression.AbacRuleValue
+	WhoAbac           types.Object
+	WhoAbacExpression abac_expression.AbacExpressionValue
 	WhoLocked         types.Bool
 	InheritanceLocked types.Bool
+	Locks             types.Set
+	LockReason        types.String
 
 	Owners types.Set
+
+	Timeouts timeouts.Value
 }
 
 type AccessProviderModel[T any] interface {
@@ -63,7 +68,11 @@ type ValidationHook[T any, ApModel AccessProviderModel[T]] func(ctx context.Cont
 type PlanModifierHook[T any, ApModel AccessProviderModel[T]] func(ctx context.Context, data ApModel) (ApModel, diag.Diagnostics)
 
 type AccessProviderResource[T any, ApModel AccessProviderModel[T]] struct {
-	client *sdk.RaitoClient
+	client     *sdk.RaitoClient
+	lockReason string
+
+	// resourceType is the Terraform type name of the resource (e.g. raito_grant). It is used to render the lock reason.
+	resourceType string
 
 	readHooks         []ReadHook[T, ApModel]
 	validationHooks   []ValidationHook[T, ApModel]
@@ -162,6 +171,22 @@ func (a *AccessProviderResource[T, ApModel]) schema(typeName string) map[string]
 							int64validator.AtLeast(1),
 						},
 					},
+					"expires_at": schema.StringAttribute{
+						Required:            false,
+						Optional:            true,
+						Computed:            false,
+						Sensitive:           false,
+						Description:         "The RFC3339 timestamp (e.g. 2025-01-31T18:00:00Z) at which this who-item expires. Expired who-items are removed by Raito Cloud and are not granted again.",
+						MarkdownDescription: "The RFC3339 timestamp (e.g. `2025-01-31T18:00:00Z`) at which this who-item expires. Expired who-items are removed by Raito Cloud and are not granted again. Cannot be set if `expires_after` is set.",
+					},
+					"expires_after": schema.StringAttribute{
+						Required:            false,
+						Optional:            true,
+						Computed:            false,
+						Sensitive:           false,
+						Description:         "The duration (e.g. 72h) after which this who-item expires, starting from the moment it is granted. Expired who-items are removed by Raito Cloud and are not granted again.",
+						MarkdownDescription: "The duration (e.g. `72h`) after which this who-item expires, starting from the moment it is granted. Expired who-items are removed by Raito Cloud and are not granted again. Cannot be set if `expires_at` is set.",
+					},
 				},
 				CustomType:    nil,
 				Validators:    nil,
@@ -175,13 +200,26 @@ func (a *AccessProviderResource[T, ApModel]) schema(typeName string) map[string]
 			MarkdownDescription: fmt.Sprintf("The who-items associated with the %s. When this is not set (nil), the who-list will not be overridden. This is typically used when this should be managed from Raito Cloud.", typeName),
 		},
 		"who_abac_rule": schema.StringAttribute{
-			CustomType:          jsontypes.NormalizedType{},
+			CustomType:          abac_expression.AbacRuleType{},
 			Required:            false,
 			Optional:            true,
 			Computed:            false,
 			Sensitive:           false,
 			Description:         fmt.Sprintf("json representation of the abac rule for who-items associated with the %s", typeName),
-			MarkdownDescription: fmt.Sprintf("json representation of the abac rule for who-items associated with the %s", typeName),
+			MarkdownDescription: fmt.Sprintf("json representation of the abac rule for who-items associated with the %s. Cannot be set if `who`, `who_abac` or `who_abac_expression` is set.", typeName),
+		},
+		"who_abac": abacRuleSchemaAttribute(
+			fmt.Sprintf("Structured abac rule for who-items associated with the %s. Cannot be set if who, who_abac_rule or who_abac_expression is set.", typeName),
+			fmt.Sprintf("Structured abac rule for who-items associated with the %s. The rule matches if all conditions of at least one `any_of` item match. Cannot be set if `who`, `who_abac_rule` or `who_abac_expression` is set.", typeName),
+		),
+		"who_abac_expression": schema.StringAttribute{
+			CustomType:          abac_expression.AbacExpressionType{},
+			Required:            false,
+			Optional:            true,
+			Computed:            false,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("Abac rule for who-items associated with the %s, written in the abac expression language. Cannot be set if who, who_abac_rule or who_abac is set.", typeName),
+			MarkdownDescription: fmt.Sprintf("Abac rule for who-items associated with the %s, written in the abac expression language (e.g. `has_tag(\"department\", \"Finance\") and not has_tag(\"role\", \"intern\")`). See the abac guide for the syntax. Cannot be set if `who`, `who_abac_rule` or `who_abac` is set.", typeName),
 		},
 		"who_locked": schema.BoolAttribute{
 			Required:            false,
@@ -201,6 +239,32 @@ func (a *AccessProviderResource[T, ApModel]) schema(typeName string) map[string]
 			MarkdownDescription: "Indicates if who should be locked. This should be true if who access providers are set.",
 			Validators:          nil,
 		},
+		"locks": schema.SetAttribute{
+			ElementType:         types.StringType,
+			Required:            false,
+			Optional:            true,
+			Computed:            true,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The additional locks that are set on the %s. Possible values are: [%q, %q]. The name lock prevents the name from being changed in Raito Cloud, the delete lock prevents the %s from being deleted in Raito Cloud. Default: [%q]", typeName, nameLock, deleteLock, typeName, nameLock),
+			MarkdownDescription: fmt.Sprintf("The additional locks that are set on the %s. Possible values are: [`%q`, `%q`]. The name lock prevents the name from being changed in Raito Cloud, the delete lock prevents the %s from being deleted in Raito Cloud. Terraform itself is still able to delete it. Default: [`%q`]", typeName, nameLock, deleteLock, typeName, nameLock),
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(
+					stringvalidator.OneOf(nameLock, deleteLock),
+				),
+			},
+			Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue(nameLock)})),
+		},
+		"lock_reason": schema.StringAttribute{
+			Required:            false,
+			Optional:            true,
+			Computed:            false,
+			Sensitive:           false,
+			Description:         fmt.Sprintf("The reason shown in Raito Cloud on all locks of the %s. Overrides the lock_reason of the provider.", typeName),
+			MarkdownDescription: fmt.Sprintf("The reason shown in Raito Cloud on all locks of the %s. Overrides the `lock_reason` of the provider. %s", typeName, lockReasonPlaceholdersDescription),
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
 		"owners": schema.SetAttribute{
 			ElementType:         types.StringType,
 			Required:            false,
@@ -230,6 +294,16 @@ func (a *AccessProviderResource[T, ApModel]) Create(ctx context.Context, request
 		return
 	}
 
+	createTimeout, diagnostics := ApModel(&data).GetAccessProviderResourceModel().Timeouts.Create(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "create", createTimeout, &response.Diagnostics)
+	defer done()
+
 	a.create(ctx, &data, response)
 }
 
@@ -247,10 +321,12 @@ func (a *AccessProviderResource[T, ApModel]) create(ctx context.Context, data Ap
 		return
 	}
 
+	setLockReason(&input, a.renderLockReason(apResourceModel))
+
 	// Create the access provider
 	ap, err := a.client.AccessProvider().CreateAccessProvider(ctx, input)
 	if err != nil {
-		response.Diagnostics.AddError("Failed to create access provider", err.Error())
+		response.Diagnostics.Append(accessProviderMutationDiagnostics("Failed to create access provider", &input, err)...)
 
 		return
 	}
@@ -349,6 +425,16 @@ func (a *AccessProviderResource[T, ApModel]) Read(ctx context.Context, request r
 		return
 	}
 
+	readTimeout, diagnostics := ApModel(&data).GetAccessProviderResourceModel().Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
 	a.read(ctx, &data, response, a.readHooks...)
 }
 
@@ -387,37 +473,34 @@ func (a *AccessProviderResource[T, ApModel]) read(ctx context.Context, data ApMo
 	// If who in initial state is not nil, get all who-items
 	if !apModel.Who.IsNull() {
 		definedPromises := set.Set[string]{}
+		definedWhoItems := map[string]types.Object{}
 
 		// Search al promises defined in the terraform state
 		for _, whoItem := range apModel.Who.Elements() {
 			whoItemObject := whoItem.(types.Object)
 			attributes := whoItemObject.Attributes()
 
+			key, ok := whoItemKey(attributes)
+			if !ok {
+				continue
+			}
+
+			definedWhoItems[key] = whoItemObject
+
 			if !attributes["promise_duration"].IsNull() {
-				if !attributes["user"].IsNull() {
-					definedPromises.Add(_userPrefix(attributes["user"].(types.String).ValueString()))
-				} else if !attributes["group"].IsNull() {
-					definedPromises.Add(_groupPrefix(attributes["group"].(types.String).ValueString()))
-				} else if !attributes["access_control"].IsNull() {
-					definedPromises.Add(_accessControlPrefix(attributes["access_control"].(types.String).ValueString()))
-				}
+				definedPromises.Add(key)
 			}
 		}
 
 		stateWhoItems := make([]attr.Value, 0)
 
-		stateWhoItems, done := a.readWhoItems(ctx, apModel, response, definedPromises, stateWhoItems)
+		stateWhoItems, done := a.readWhoItems(ctx, apModel, response, definedPromises, definedWhoItems, stateWhoItems)
 		if done {
 			return
 		}
 
 		who, whoDiag := types.SetValue(types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"user":             types.StringType,
-				"group":            types.StringType,
-				"access_control":   types.StringType,
-				"promise_duration": types.Int64Type,
-			},
+			AttrTypes: whoItemAttributeTypes,
 		}, stateWhoItems)
 
 		response.Diagnostics.Append(whoDiag...)
@@ -429,8 +512,19 @@ func (a *AccessProviderResource[T, ApModel]) read(ctx context.Context, data ApMo
 		apModel.Who = who
 	}
 
-	if !apModel.Who.IsNull() && ap.WhoAbacRule != nil {
-		apModel.WhoAbacRule = jsontypes.NewNormalizedPointerValue(ap.WhoAbacRule.RuleJson)
+	imported, diagnostics := isImported(ctx, response.Private)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if ap.WhoAbacRule != nil {
+		response.Diagnostics.Append(readWhoAbacRule(ctx, apModel, serverAbacRule{Rule: ap.WhoAbacRule.Rule, RuleJson: ap.WhoAbacRule.RuleJson}, imported)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Set all global access provider attributes
@@ -455,62 +549,174 @@ func (a *AccessProviderResource[T, ApModel]) read(ctx context.Context, data ApMo
 		}
 	}
 
+	// The who abac rule is only set from Raito Cloud on the first read after an import.
+	if imported {
+		response.Diagnostics.Append(response.Private.SetKey(ctx, importedKey, nil)...)
+	}
+
 	// Set new state of the access provider
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
-func (a *AccessProviderResource[T, ApModel]) readWhoItems(ctx context.Context, apModel *AccessProviderResourceModel, response *resource.ReadResponse, definedPromises set.Set[string], stateWhoItems []attr.Value) ([]attr.Value, bool) {
+// readWhoAbacRule sets the who abac rule read from Raito Cloud in the representation (json, structured or abac expression) of the state.
+// On import, none of the who attributes is set yet and the json representation is used.
+// Otherwise, the who abac rule is only read if one of the who attributes is managed by Terraform.
+func readWhoAbacRule(ctx context.Context, apModel *AccessProviderResourceModel, serverRule serverAbacRule, imported bool) (diagnostics diag.Diagnostics) {
+	if !apModel.Who.IsNull() || !apModel.WhoAbacRule.IsNull() || imported {
+		apModel.WhoAbacRule, diagnostics = abacRuleValueFromServer(serverRule, apModel.WhoAbacRule, path.Root("who_abac_rule"))
+
+		return diagnostics
+	}
+
+	if apModel.WhoAbac.IsNull() && apModel.WhoAbacExpression.IsNull() {
+		return diagnostics
+	}
+
+	if !apModel.WhoAbac.IsNull() {
+		var whoAbac types.Object
+
+		whoAbac, diagnostics = abacRuleObjectFromServer(ctx, serverRule, apModel.WhoAbac, path.Root("who_abac"))
+		if !whoAbac.IsNull() {
+			apModel.WhoAbac = whoAbac
+		}
+
+		return diagnostics
+	}
+
+	var whoAbacExpression abac_expression.AbacExpressionValue
+
+	whoAbacExpression, diagnostics = abacExpressionFromServer(serverRule, path.Root("who_abac_expression"))
+	if !whoAbacExpression.IsNull() {
+		apModel.WhoAbacExpression = whoAbacExpression
+	}
+
+	return diagnostics
+}
+
+func (a *AccessProviderResource[T, ApModel]) readWhoItems(ctx context.Context, apModel *AccessProviderResourceModel, response *resource.ReadResponse, definedPromises set.Set[string], definedWhoItems map[string]types.Object, stateWhoItems []attr.Value) ([]attr.Value, bool) {
+	// The private state of the response is initialised with the private state of the request.
+	recordedExpiries, diagnostics := getWhoItemExpiries(ctx, response.Private)
+	response.Diagnostics.Append(diagnostics...)
+
+	if diagnostics.HasError() {
+		return nil, true
+	}
+
+	whoItems, expiries, diagnostics := readAccessProviderWhoItems(ctx, a.client, apModel.Id.ValueString(), definedPromises, definedWhoItems, recordedExpiries)
+	response.Diagnostics.Append(diagnostics...)
+
+	if diagnostics.HasError() {
+		return nil, true
+	}
+
+	response.Diagnostics.Append(setWhoItemExpiries(ctx, response.Private, expiries)...)
+
+	if response.Diagnostics.HasError() {
+		return nil, true
+	}
+
+	return append(stateWhoItems, whoItems...), false
+}
+
+// readAccessProviderWhoItems returns the who-items of the access provider in Raito Cloud.
+// Implemented promises of definedPromises are ignored. Expiry settings and expired who-items are taken over from definedWhoItems.
+// A defined who-item with expires_after that is no longer present is only kept if its expiry timestamp in recordedExpiries has passed.
+// The returned expiries are the expiry timestamps to record for the next read.
+func readAccessProviderWhoItems(ctx context.Context, client *sdk.RaitoClient, apId string, definedPromises set.Set[string], definedWhoItems map[string]types.Object, recordedExpiries whoItemExpiries) (stateWhoItems []attr.Value, expiries whoItemExpiries, diagnostics diag.Diagnostics) {
 	// Get all who-items. Ignore implemented promises.
 	cancelCtx, cancelFn := context.WithCancel(ctx)
 	defer cancelFn()
 
-	whoItems := a.client.AccessProvider().GetAccessProviderWhoList(cancelCtx, apModel.Id.ValueString())
+	stateWhoItems = make([]attr.Value, 0)
+	expiries = whoItemExpiries{}
+	foundWhoItems := set.Set[string]{}
+
+	whoItems := client.AccessProvider().GetAccessProviderWhoList(cancelCtx, apId)
 	for whoItem := range whoItems {
 		if whoItem.HasError() {
-			response.Diagnostics.AddError("Failed to read who-item from access provider", whoItem.GetError().Error())
+			diagnostics.AddError("Failed to read who-item from access provider", whoItem.GetError().Error())
 
-			return nil, true
+			return nil, nil, diagnostics
 		}
 
 		var user, group, whoAp *string
+		var key string
 
 		item := whoItem.GetItem()
 		switch benificiaryItem := item.Item.(type) {
 		case *raitoType.AccessProviderWhoListItemItemUser:
 			user = benificiaryItem.Email
+
+			if user != nil {
+				key = _userPrefix(*user)
+			}
 		case *raitoType.AccessProviderWhoListItemItemGroup:
 			group = &benificiaryItem.Id
+			key = _groupPrefix(*group)
 		case *raitoType.AccessProviderWhoListItemItemAccessProvider:
 			whoAp = &benificiaryItem.Id
+			key = _accessControlPrefix(*whoAp)
 		default:
-			response.Diagnostics.AddError("Invalid who-item", fmt.Sprintf("Invalid who-item: %T", benificiaryItem))
+			diagnostics.AddError("Invalid who-item", fmt.Sprintf("Invalid who-item: %T", benificiaryItem))
 
-			return nil, true
+			return nil, nil, diagnostics
 		}
 
 		if item.Type == raitoType.AccessWhoItemTypeWhogrant {
-			if (user != nil && definedPromises.Contains(_userPrefix(*user))) || (group != nil && definedPromises.Contains(_groupPrefix(*group))) || (whoAp != nil && definedPromises.Contains(_accessControlPrefix(*whoAp))) {
+			if key != "" && definedPromises.Contains(key) {
 				continue
 			}
 		} else if item.PromiseDuration == nil {
-			response.Diagnostics.AddError("Invalid who-item detected.", "Invalid who-item. Promise duration not set on promise who-item")
+			diagnostics.AddError("Invalid who-item detected.", "Invalid who-item. Promise duration not set on promise who-item")
 		}
 
+		foundWhoItems.Add(key)
+
+		if key != "" && item.ExpiresAfter != nil && item.ExpiresAt != nil {
+			expiries[key] = *item.ExpiresAt
+		}
+
+		var stateAttributes map[string]attr.Value
+		if definedWhoItem, found := definedWhoItems[key]; found {
+			stateAttributes = definedWhoItem.Attributes()
+		}
+
+		expiresAt, expiresAfter := whoItemExpiry(stateAttributes, item)
+
 		stateWhoItems = append(stateWhoItems, types.ObjectValueMust(
-			map[string]attr.Type{
-				"user":             types.StringType,
-				"group":            types.StringType,
-				"access_control":   types.StringType,
-				"promise_duration": types.Int64Type,
-			}, map[string]attr.Value{
+			whoItemAttributeTypes, map[string]attr.Value{
 				"user":             types.StringPointerValue(user),
 				"group":            types.StringPointerValue(group),
 				"access_control":   types.StringPointerValue(whoAp),
 				"promise_duration": types.Int64PointerValue(item.PromiseDuration),
+				"expires_at":       expiresAt,
+				"expires_after":    expiresAfter,
 			}))
 	}
 
-	return stateWhoItems, false
+	// Keep expired who-items in the state. Otherwise, they would be granted again on the next apply.
+	now := time.Now()
+
+	for key, definedWhoItem := range definedWhoItems {
+		if foundWhoItems.Contains(key) {
+			continue
+		}
+
+		var recordedExpiry *time.Time
+		if expiry, found := recordedExpiries[key]; found {
+			recordedExpiry = &expiry
+		}
+
+		if whoItemMayHaveExpired(definedWhoItem.Attributes(), recordedExpiry, now) {
+			stateWhoItems = append(stateWhoItems, definedWhoItem)
+
+			if recordedExpiry != nil {
+				expiries[key] = *recordedExpiry
+			}
+		}
+	}
+
+	return stateWhoItems, expiries, diagnostics
 }
 
 func (a *AccessProviderResource[T, ApModel]) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -522,10 +728,35 @@ func (a *AccessProviderResource[T, ApModel]) Update(ctx context.Context, request
 		return
 	}
 
-	a.update(ctx, &data, response)
+	updateTimeout, diagnostics := ApModel(&data).GetAccessProviderResourceModel().Timeouts.Update(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "update", updateTimeout, &response.Diagnostics)
+	defer done()
+
+	var stateData T
+
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	recordedExpiries, diagnostics := getWhoItemExpiries(ctx, request.Private)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	a.update(ctx, &data, ApModel(&stateData).GetAccessProviderResourceModel().Who, recordedExpiries, response)
 }
 
-func (a *AccessProviderResource[T, ApModel]) update(ctx context.Context, data ApModel, response *resource.UpdateResponse) {
+func (a *AccessProviderResource[T, ApModel]) update(ctx context.Context, data ApModel, priorWho types.Set, recordedExpiries whoItemExpiries, response *resource.UpdateResponse) {
 	input := raitoType.AccessProviderInput{}
 
 	apResourceModel := data.GetAccessProviderResourceModel()
@@ -534,12 +765,14 @@ func (a *AccessProviderResource[T, ApModel]) update(ctx context.Context, data Ap
 	state := apResourceModel.State
 	owners := apResourceModel.Owners
 
-	response.Diagnostics.Append(data.ToAccessProviderInput(ctx, a.client, &input)...)
+	response.Diagnostics.Append(a.toAccessProviderInputWithoutExpiredWhoItems(ctx, data, priorWho, recordedExpiries, &input)...)
 
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	setLockReason(&input, a.renderLockReason(apResourceModel))
+
 	// Check for implemented promises
 	definedPromises := set.Set[string]{}
 
@@ -562,7 +795,7 @@ func (a *AccessProviderResource[T, ApModel]) update(ctx context.Context, data Ap
 	// Update access provider
 	ap, err := a.client.AccessProvider().UpdateAccessProvider(ctx, id, input, services.WithAccessProviderOverrideLocks())
 	if err != nil {
-		response.Diagnostics.AddError("Failed to update access provider", err.Error())
+		response.Diagnostics.Append(accessProviderMutationDiagnostics("Failed to update access provider", &input, err)...)
 
 		return
 	}
@@ -593,6 +826,71 @@ func (a *AccessProviderResource[T, ApModel]) update(ctx context.Context, data Ap
 	response.Diagnostics.Append(a.createUpdateOwners(ctx, data, owners, ap, &response.State)...)
 }
 
+// toAccessProviderInputWithoutExpiredWhoItems converts the model to an access provider input.
+// Who-items with expires_after that expired since the previous apply are not granted again.
+func (a *AccessProviderResource[T, ApModel]) toAccessProviderInputWithoutExpiredWhoItems(ctx context.Context, data ApModel, priorWho types.Set, recordedExpiries whoItemExpiries, input *raitoType.AccessProviderInput) (diagnostics diag.Diagnostics) {
+	apResourceModel := data.GetAccessProviderResourceModel()
+	plannedWho := apResourceModel.Who
+
+	if !priorWho.IsNull() && whoItemsWithExpiresAfter(priorWho) {
+		currentKeys, keyDiagnostics := a.currentWhoItemKeys(ctx, apResourceModel.Id.ValueString())
+		diagnostics.Append(keyDiagnostics...)
+
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+
+		activeWho, whoDiagnostics := withoutExpiredWhoItems(plannedWho, priorWho, currentKeys, recordedExpiries, time.Now())
+		diagnostics.Append(whoDiagnostics...)
+
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+
+		apResourceModel.Who = activeWho
+		data.SetAccessProviderResourceModel(apResourceModel)
+
+		// Restore the planned who-items, so expired who-items are kept in the state.
+		defer func() {
+			apResourceModel.Who = plannedWho
+			data.SetAccessProviderResourceModel(apResourceModel)
+		}()
+	}
+
+	diagnostics.Append(data.ToAccessProviderInput(ctx, a.client, input)...)
+
+	return diagnostics
+}
+
+// currentWhoItemKeys returns the keys of all who-items currently granted by the access provider in Raito Cloud.
+func (a *AccessProviderResource[T, ApModel]) currentWhoItemKeys(ctx context.Context, id string) (_ set.Set[string], diagnostics diag.Diagnostics) {
+	cancelCtx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+
+	keys := set.Set[string]{}
+
+	for whoItem := range a.client.AccessProvider().GetAccessProviderWhoList(cancelCtx, id) {
+		if whoItem.HasError() {
+			diagnostics.AddError("Failed to read who-item from access provider", whoItem.GetError().Error())
+
+			return keys, diagnostics
+		}
+
+		switch beneficiaryItem := whoItem.GetItem().Item.(type) {
+		case *raitoType.AccessProviderWhoListItemItemUser:
+			if beneficiaryItem.Email != nil {
+				keys.Add(_userPrefix(*beneficiaryItem.Email))
+			}
+		case *raitoType.AccessProviderWhoListItemItemGroup:
+			keys.Add(_groupPrefix(beneficiaryItem.Id))
+		case *raitoType.AccessProviderWhoListItemItemAccessProvider:
+			keys.Add(_accessControlPrefix(beneficiaryItem.Id))
+		}
+	}
+
+	return keys, diagnostics
+}
+
 func (a *AccessProviderResource[T, ApModel]) updateGetWhoItems(ctx context.Context, id string, response *resource.UpdateResponse, definedPromises set.Set[string], input raitoType.AccessProviderInput) bool {
 	cancelCtx, cancelFn := context.WithCancel(ctx)
 	defer cancelFn()
@@ -656,6 +954,16 @@ func (a *AccessProviderResource[T, ApModel]) Delete(ctx context.Context, request
 
 	apModel := ApModel(&data)
 
+	deleteTimeout, diagnostics := apModel.GetAccessProviderResourceModel().Timeouts.Delete(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "delete", deleteTimeout, &response.Diagnostics)
+	defer done()
+
 	err := a.client.AccessProvider().DeleteAccessProvider(ctx, apModel.GetAccessProviderResourceModel().Id.ValueString(), services.WithAccessProviderOverrideLocks())
 	if err != nil {
 		response.Diagnostics.AddError("Failed to delete access provider", err.Error())
@@ -672,18 +980,18 @@ func (a *AccessProviderResource[T, ApModel]) Configure(_ context.Context, req re
 		return
 	}
 
-	client, ok := req.ProviderData.(*sdk.RaitoClient)
+	providerData, ok := req.ProviderData.(*RaitoResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.RaitoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *internal.RaitoResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	if client == nil {
+	if providerData == nil || providerData.Client == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
 			"Expected *sdk.RaitoClient, not to be nil.",
@@ -692,11 +1000,40 @@ func (a *AccessProviderResource[T, ApModel]) Configure(_ context.Context, req re
 		return
 	}
 
-	a.client = client
+	a.client = providerData.Client
+	a.lockReason = providerData.LockReason
+}
+
+// renderLockReason returns the reason that is set on all locks of the access provider.
+// The lock_reason of the resource takes precedence over the lock_reason of the provider.
+func (a *AccessProviderResource[T, ApModel]) renderLockReason(data *AccessProviderResourceModel) string {
+	template := a.lockReason
+
+	if !data.LockReason.IsNull() && !data.LockReason.IsUnknown() {
+		template = data.LockReason.ValueString()
+	}
+
+	if template == "" {
+		template = lockMsg
+	}
+
+	return renderLockReason(template, a.resourceType, data.Name.ValueString(), os.Getenv)
 }
 
 func (a *AccessProviderResource[T, ApModel]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, []byte("true"))...)
+}
+
+// importedKey is the private state key that marks an access provider that was imported and not read yet.
+const importedKey = "imported"
+
+// isImported indicates whether the access provider was imported and not read yet.
+func isImported(ctx context.Context, private privateStateGetter) (bool, diag.Diagnostics) {
+	value, diagnostics := private.GetKey(ctx, importedKey)
+
+	return len(value) > 0, diagnostics
 }
 
 func (a *AccessProviderResource[T, ApModel]) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
@@ -713,15 +1050,29 @@ func (a *AccessProviderResource[T, ApModel]) ValidateConfig(ctx context.Context,
 	apResourceModel := apModel.GetAccessProviderResourceModel()
 
 	who := &apResourceModel.Who
-	whoAbac := &apResourceModel.WhoAbacRule
+
+	whoAbacRulesDefined := 0
+
+	for _, whoAbacRule := range []attr.Value{apResourceModel.WhoAbacRule, apResourceModel.WhoAbac, apResourceModel.WhoAbacExpression} {
+		if !whoAbacRule.IsNull() {
+			whoAbacRulesDefined++
+		}
+	}
+
+	whoAbacDefined := whoAbacRulesDefined > 0
 
 	whoGroupsOrUsersDefined := false
 	whoAccessProvidersDefined := false
 
-	if !who.IsNull() && !whoAbac.IsNull() {
+	if !who.IsNull() && whoAbacDefined {
 		response.Diagnostics.AddError(
 			"Cannot specify both who and who_abac",
-			"Please specify only one of who or who_abac",
+			"Please specify only one of who, who_abac_rule, who_abac or who_abac_expression",
+		)
+	} else if whoAbacRulesDefined > 1 {
+		response.Diagnostics.AddError(
+			"Cannot specify multiple who abac rules",
+			"Please specify only one of who_abac_rule, who_abac or who_abac_expression",
 		)
 	} else if !who.IsNull() { // For each who-item check if exactly one of user, group or access_control is set.
 		for _, whoItem := range who.Elements() {
@@ -750,12 +1101,19 @@ func (a *AccessProviderResource[T, ApModel]) ValidateConfig(ctx context.Context,
 
 				break
 			}
+
+			response.Diagnostics.Append(validateWhoItemExpiry(attributes)...)
 		}
 	}
 
-	if whoGroupsOrUsersDefined || !whoAbac.IsNull() {
+	_, whoAbacDiagnostics := abacRuleToBinaryExpression(ctx, apResourceModel.WhoAbac, path.Root("who_abac"))
+	response.Diagnostics.Append(whoAbacDiagnostics...)
+	response.Diagnostics.Append(validateAbacRuleJson(apResourceModel.WhoAbacRule, path.Root("who_abac_rule"))...)
+	response.Diagnostics.Append(validateAbacExpression(apResourceModel.WhoAbacExpression, path.Root("who_abac_expression"))...)
+
+	if whoGroupsOrUsersDefined || whoAbacDefined {
 		if !apResourceModel.WhoLocked.IsNull() && !apResourceModel.WhoLocked.ValueBool() {
-			response.Diagnostics.AddError("Who must be locked", "Who must be locked if who users, who groups or who_abac_rule is set.")
+			response.Diagnostics.AddError("Who must be locked", "Who must be locked if who users, who groups, who_abac_rule, who_abac or who_abac_expression is set.")
 		}
 	}
 
@@ -770,11 +1128,16 @@ func (a *AccessProviderResource[T, ApModel]) ValidateConfig(ctx context.Context,
 	}
 }
 
-func (a *AccessProviderResource[T, ApModel]) readOwners(ctx context.Context, apId string) (_ types.Set, diagnostics diag.Diagnostics) {
+func (a *AccessProviderResource[T, ApModel]) readOwners(ctx context.Context, apId string) (types.Set, diag.Diagnostics) {
+	return readAccessProviderOwners(ctx, a.client, apId)
+}
+
+// readAccessProviderOwners returns the IDs of the users and groups that own the access provider.
+func readAccessProviderOwners(ctx context.Context, client *sdk.RaitoClient, apId string) (_ types.Set, diagnostics diag.Diagnostics) {
 	cancelCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	roleAssignments := a.client.Role().ListRoleAssignmentsOnAccessProvider(cancelCtx, apId, services.WithRoleAssignmentListFilter(&raitoType.RoleAssignmentFilterInput{
+	roleAssignments := client.Role().ListRoleAssignmentsOnAccessProvider(cancelCtx, apId, services.WithRoleAssignmentListFilter(&raitoType.RoleAssignmentFilterInput{
 		Role: utils.Ptr(ownerRole),
 	}))
 
@@ -848,7 +1211,7 @@ func (a *AccessProviderResource[T, ApModel]) ModifyPlan(ctx context.Context, req
 		}
 	}
 
-	if whoGroupsOrUsersDefined || !apResourceModel.WhoAbacRule.IsNull() {
+	if whoGroupsOrUsersDefined || !apResourceModel.WhoAbacRule.IsNull() || !apResourceModel.WhoAbac.IsNull() || !apResourceModel.WhoAbacExpression.IsNull() {
 		apResourceModel.WhoLocked = types.BoolValue(true)
 	} else if apResourceModel.WhoLocked.IsUnknown() {
 		apResourceModel.WhoLocked = types.BoolValue(false)
@@ -879,14 +1242,11 @@ func (a *AccessProviderResource[T, ApModel]) ModifyPlan(ctx context.Context, req
 func (a *AccessProviderResourceModel) ToAccessProviderInput(ctx context.Context, client *sdk.RaitoClient, result *raitoType.AccessProviderInput) (diagnostics diag.Diagnostics) {
 	result.Name = a.Name.ValueStringPointer()
 	result.Description = a.Description.ValueStringPointer()
-	result.Locks = append(result.Locks,
-		raitoType.AccessProviderLockDataInput{
-			LockKey: raitoType.AccessProviderLockNamelock,
-			Details: &raitoType.AccessProviderLockDetailsInput{
-				Reason: utils.Ptr(lockMsg),
-			},
-		},
-	)
+	diagnostics.Append(a.locksToAccessProviderInput(ctx, result)...)
+
+	if diagnostics.HasError() {
+		return diagnostics
+	}
 
 	result.WhoType = utils.Ptr(raitoType.WhoAndWhatTypeStatic)
 
@@ -895,6 +1255,12 @@ func (a *AccessProviderResourceModel) ToAccessProviderInput(ctx context.Context,
 	} else if !a.WhoAbacRule.IsNull() && !a.WhoAbacRule.IsUnknown() {
 		result.WhoType = utils.Ptr(raitoType.WhoAndWhatTypeDynamic)
 		diagnostics.Append(a.whoAbacRuleToAccessProviderInput(result)...)
+	} else if !a.WhoAbac.IsNull() && !a.WhoAbac.IsUnknown() {
+		result.WhoType = utils.Ptr(raitoType.WhoAndWhatTypeDynamic)
+		diagnostics.Append(a.whoAbacToAccessProviderInput(ctx, result)...)
+	} else if !a.WhoAbacExpression.IsNull() && !a.WhoAbacExpression.IsUnknown() {
+		result.WhoType = utils.Ptr(raitoType.WhoAndWhatTypeDynamic)
+		diagnostics.Append(a.whoAbacExpressionToAccessProviderInput(result)...)
 	}
 
 	if a.WhoLocked.ValueBool() {
@@ -931,19 +1297,76 @@ func (a *AccessProviderResourceModel) ToAccessProviderInput(ctx context.Context,
 	return diagnostics
 }
 
+func (a *AccessProviderResourceModel) locksToAccessProviderInput(ctx context.Context, result *raitoType.AccessProviderInput) (diagnostics diag.Diagnostics) {
+	// Only the name lock is set if the locks are not known yet, as this was the behaviour before the locks attribute existed.
+	locks := []string{nameLock}
+
+	if !a.Locks.IsNull() && !a.Locks.IsUnknown() {
+		locks = nil
+
+		diagnostics.Append(a.Locks.ElementsAs(ctx, &locks, false)...)
+
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+	}
+
+	for _, lock := range locks {
+		lockKey, found := selectableLocks[lock]
+		if !found {
+			diagnostics.AddAttributeError(path.Root("locks"), "Invalid lock", fmt.Sprintf("Unknown lock %q. Expected one of %q or %q.", lock, nameLock, deleteLock))
+
+			continue
+		}
+
+		result.Locks = append(result.Locks, raitoType.AccessProviderLockDataInput{
+			LockKey: lockKey,
+			Details: &raitoType.AccessProviderLockDetailsInput{
+				Reason: utils.Ptr(lockMsg),
+			},
+		})
+	}
+
+	return diagnostics
+}
+
 func (a *AccessProviderResourceModel) whoElementsToAccessProviderInput(ctx context.Context, client *sdk.RaitoClient, result *raitoType.AccessProviderInput) (diagnostics diag.Diagnostics) {
 	whoItems := a.Who.Elements()
 
 	result.WhoItems = make([]raitoType.WhoItemInput, 0, len(whoItems))
 
+	now := time.Now()
+
 	for _, whoItem := range whoItems {
 		whoObject := whoItem.(types.Object)
 		whoAttributes := whoObject.Attributes()
 
+		// Expired who-items are not granted again
+		if whoItemExpired(whoAttributes, now) {
+			continue
+		}
+
 		raitoWhoItem := raitoType.WhoItemInput{
 			Type: utils.Ptr(raitoType.AccessWhoItemTypeWhogrant),
 		}
 
+		expiresAt, err := parseExpiresAt(whoAttributes["expires_at"])
+		if err != nil {
+			diagnostics.AddError("Invalid expires_at", err.Error())
+
+			continue
+		}
+
+		expiresAfter, err := parseExpiresAfter(whoAttributes["expires_after"])
+		if err != nil {
+			diagnostics.AddError("Invalid expires_after", err.Error())
+
+			continue
+		}
+
+		raitoWhoItem.ExpiresAt = expiresAt
+		raitoWhoItem.ExpiresAfter = expiresAfter
+
 		if promiseDurationAttribute, found := whoAttributes["promise_duration"]; found && !promiseDurationAttribute.IsNull() {
 			promiseDurationInt := promiseDurationAttribute.(types.Int64)
 			raitoWhoItem.PromiseDuration = promiseDurationInt.ValueInt64Pointer()
@@ -986,7 +1409,7 @@ func (a *AccessProviderResourceModel) whoAbacRuleToAccessProviderInput(result *r
 		return diagnostics
 	}
 
-	rule, err := abacBeRule.ToGqlInput()
+	rule, err := abacRuleToGqlInput(&abacBeRule)
 	if err != nil {
 		diagnostics.AddError("Failed to convert abac-rule to gql", err.Error())
 
@@ -1001,6 +1424,52 @@ func (a *AccessProviderResourceModel) whoAbacRuleToAccessProviderInput(result *r
 	return diagnostics
 }
 
+func (a *AccessProviderResourceModel) whoAbacToAccessProviderInput(ctx context.Context, result *raitoType.AccessProviderInput) (diagnostics diag.Diagnostics) {
+	abacBeRule, abacDiagnostics := abacRuleToBinaryExpression(ctx, a.WhoAbac, path.Root("who_abac"))
+	diagnostics.Append(abacDiagnostics...)
+
+	if diagnostics.HasError() || abacBeRule == nil {
+		return diagnostics
+	}
+
+	rule, err := abacRuleToGqlInput(abacBeRule)
+	if err != nil {
+		diagnostics.AddError("Failed to convert abac-rule to gql", err.Error())
+
+		return diagnostics
+	}
+
+	result.WhoAbacRule = &raitoType.WhoAbacRuleInput{
+		Rule: *rule,
+		Type: raitoType.AccessWhoItemTypeWhogrant,
+	}
+
+	return diagnostics
+}
+
+func (a *AccessProviderResourceModel) whoAbacExpressionToAccessProviderInput(result *raitoType.AccessProviderInput) (diagnostics diag.Diagnostics) {
+	abacBeRule, err := a.WhoAbacExpression.Parse()
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("who_abac_expression"), "Invalid abac expression", err.Error())
+
+		return diagnostics
+	}
+
+	rule, err := abacRuleToGqlInput(abacBeRule)
+	if err != nil {
+		diagnostics.AddError("Failed to convert abac-rule to gql", err.Error())
+
+		return diagnostics
+	}
+
+	result.WhoAbacRule = &raitoType.WhoAbacRuleInput{
+		Rule: *rule,
+		Type: raitoType.AccessWhoItemTypeWhogrant,
+	}
+
+	return diagnostics
+}
+
 func (a *AccessProviderResourceModel) FromAccessProvider(ap *raitoType.AccessProvider) (diagnostics diag.Diagnostics) {
 	a.Id = types.StringValue(ap.Id)
 	a.Name = types.StringValue(ap.Name)
@@ -1010,16 +1479,27 @@ func (a *AccessProviderResourceModel) FromAccessProvider(ap *raitoType.AccessPro
 	a.WhoLocked = types.BoolValue(false)
 	a.InheritanceLocked = types.BoolValue(false)
 
+	locks := make([]attr.Value, 0, len(selectableLocks))
+
 	for _, lock := range ap.Locks {
 		switch lock.LockKey {
 		case raitoType.AccessProviderLockWholock:
 			a.WhoLocked = types.BoolValue(true)
 		case raitoType.AccessProviderLockInheritancelock:
 			a.InheritanceLocked = types.BoolValue(true)
+		case raitoType.AccessProviderLockNamelock:
+			locks = append(locks, types.StringValue(nameLock))
+		case raitoType.AccessProviderLockDeletelock:
+			locks = append(locks, types.StringValue(deleteLock))
 		default:
 		}
 	}
 
+	lockSet, lockDiagnostics := types.SetValue(types.StringType, locks)
+	diagnostics.Append(lockDiagnostics...)
+
+	a.Locks = lockSet
+
 	return diagnostics
 }
 
@@ -1098,7 +1578,7 @@ func (p AccessProviderWhatAbacParser) ToAccessProviderInput(ctx context.Context,
 		}
 	}
 
-	jsonRule := attributes["rule"].(jsontypes.Normalized)
+	jsonRule := attributes["rule"].(abac_expression.AbacRuleValue)
 
 	var abacRule abac_expression.BinaryExpression
 	diagnostics.Append(jsonRule.Unmarshal(&abacRule)...)
@@ -1107,7 +1587,7 @@ func (p AccessProviderWhatAbacParser) ToAccessProviderInput(ctx context.Context,
 		return diagnostics
 	}
 
-	abacInput, err := abacRule.ToGqlInput()
+	abacInput, err := abacRuleToGqlInput(&abacRule)
 	if err != nil {
 		diagnostics.AddError("Failed to convert abac rule to gql input", err.Error())
 
@@ -1131,7 +1611,7 @@ func (p AccessProviderWhatAbacParser) ToWhatAbacRuleObject(ctx context.Context,
 		"permissions":        types.SetType{ElemType: types.StringType},
 		"global_permissions": types.SetType{ElemType: types.StringType},
 		"scope":              types.SetType{ElemType: types.StringType},
-		"rule":               jsontypes.NormalizedType{},
+		"rule":               abac_expression.AbacRuleType{},
 	}
 
 	if len(p.ResourceFixedDoType) > 0 {
@@ -1159,7 +1639,12 @@ func (p AccessProviderWhatAbacParser) ToWhatAbacRuleObject(ctx context.Context,
 		return types.ObjectNull(objectTypes), diagnostics
 	}
 
-	abacRule := jsontypes.NewNormalizedPointerValue(ap.WhatAbacRule.RuleJson)
+	abacRule, ruleDiagnostics := abacRuleValueFromServer(serverAbacRule{Rule: ap.WhatAbacRule.Rule, RuleJson: ap.WhatAbacRule.RuleJson}, abac_expression.NewAbacRuleNull(), path.Root("what_abac_rule").AtName("rule"))
+	diagnostics.Append(ruleDiagnostics...)
+
+	if diagnostics.HasError() {
+		return types.ObjectNull(objectTypes), diagnostics
+	}
 
 	var scopeItems []attr.Value //nolint:prealloc
 
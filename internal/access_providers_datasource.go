@@ -0,0 +1,455 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	stdpath "path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/raito-io/sdk-go"
+	"github.com/raito-io/sdk-go/services"
+	raitoType "github.com/raito-io/sdk-go/types"
+	"github.com/raito-io/sdk-go/types/models"
+)
+
+var _ datasource.DataSource = (*AccessProvidersDataSource)(nil)
+
+var accessProviderActions = []models.AccessProviderAction{
+	models.AccessProviderActionGrant,
+	models.AccessProviderActionMask,
+	models.AccessProviderActionFiltered,
+	models.AccessProviderActionPurpose,
+}
+
+var accessProvidersItemAttributeTypes = map[string]attr.Type{
+	"id":           types.StringType,
+	"name":         types.StringType,
+	"action":       types.StringType,
+	"state":        types.StringType,
+	"category":     types.StringType,
+	"data_sources": types.SetType{ElemType: types.StringType},
+	"who_count":    types.Int64Type,
+	"what_count":   types.Int64Type,
+}
+
+type AccessProvidersDataSourceModel struct {
+	Action          types.String   `tfsdk:"action"`
+	State           types.String   `tfsdk:"state"`
+	Category        types.String   `tfsdk:"category"`
+	DataSource      types.String   `tfsdk:"data_source"`
+	Owner           types.String   `tfsdk:"owner"`
+	Name            types.String   `tfsdk:"name"`
+	IncludeCounts   types.Bool     `tfsdk:"include_counts"`
+	AccessProviders types.List     `tfsdk:"access_providers"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// accessProvidersFilter contains the filters of the raito_access_providers data source that are applied on the provider side.
+// Action, state, category and data source are also sent to Raito Cloud. They are checked again, as deleted access providers have to be filtered out anyway.
+type accessProvidersFilter struct {
+	Action     *string
+	State      *string
+	Category   *string
+	DataSource *string
+	Name       *string
+}
+
+// matches returns true if the access provider passes all filters. Deleted access providers never match.
+func (f *accessProvidersFilter) matches(ap *raitoType.AccessProvider) (bool, error) {
+	if ap.State == models.AccessProviderStateDeleted {
+		return false, nil
+	}
+
+	if f.Action != nil && ap.Action.String() != *f.Action {
+		return false, nil
+	}
+
+	if f.State != nil && ap.State.String() != *f.State {
+		return false, nil
+	}
+
+	if f.Category != nil && ap.Category.Id != *f.Category {
+		return false, nil
+	}
+
+	if f.DataSource != nil && !accessProviderHasDataSource(ap, *f.DataSource) {
+		return false, nil
+	}
+
+	if f.Name != nil {
+		return stdpath.Match(*f.Name, ap.Name)
+	}
+
+	return true, nil
+}
+
+type AccessProvidersDataSource struct {
+	client *sdk.RaitoClient
+}
+
+func NewAccessProvidersDataSource() datasource.DataSource {
+	return &AccessProvidersDataSource{}
+}
+
+func (a *AccessProvidersDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_access_providers"
+}
+
+func (a *AccessProvidersDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	actions := make([]string, 0, len(accessProviderActions))
+	for _, action := range accessProviderActions {
+		actions = append(actions, action.String())
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "Only return access providers with the given action",
+				MarkdownDescription: fmt.Sprintf("Only return access providers with the given action. Possible values are: [%q, %q, %q, %q]", actions[0], actions[1], actions[2], actions[3]),
+				Validators: []validator.String{
+					stringvalidator.OneOf(actions...),
+				},
+			},
+			"state": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "Only return access providers in the given state",
+				MarkdownDescription: fmt.Sprintf("Only return access providers in the given state. Possible values are: [%q, %q]", models.AccessProviderStateActive.String(), models.AccessProviderStateInactive.String()),
+				Validators: []validator.String{
+					stringvalidator.OneOf(models.AccessProviderStateActive.String(), models.AccessProviderStateInactive.String()),
+				},
+			},
+			"category": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "Only return access providers in the category with the given ID",
+				MarkdownDescription: "Only return access providers in the category with the given ID",
+			},
+			"data_source": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "Only return access providers linked to the data source with the given ID",
+				MarkdownDescription: "Only return access providers linked to the data source with the given ID",
+			},
+			"owner": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "Only return access providers owned by the user or group with the given ID",
+				MarkdownDescription: "Only return access providers owned by the user or group with the given ID",
+			},
+			"name": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "Only return access providers of which the name matches the given glob pattern (e.g. Finance*)",
+				MarkdownDescription: "Only return access providers of which the name matches the given glob pattern (e.g. `Finance*`)",
+			},
+			"include_counts": schema.BoolAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "If true, who_count and what_count are set on each access provider. Defaults to false.",
+				MarkdownDescription: "If `true`, `who_count` and `what_count` are set on each access provider. The who- and what-items themselves are not returned. Counting the items takes two extra requests per returned access provider, so this defaults to `false`.",
+			},
+			"access_providers": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the access provider",
+							MarkdownDescription: "The ID of the access provider",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the access provider",
+							MarkdownDescription: "The name of the access provider",
+						},
+						"action": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The action of the access provider",
+							MarkdownDescription: "The action of the access provider",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The state of the access provider",
+							MarkdownDescription: "The state of the access provider",
+						},
+						"category": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the category of the access provider",
+							MarkdownDescription: "The ID of the category of the access provider",
+						},
+						"data_sources": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The IDs of the data sources the access provider is linked to",
+							MarkdownDescription: "The IDs of the data sources the access provider is linked to",
+						},
+						"who_count": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The number of who-items of the access provider. Only set if include_counts is true.",
+							MarkdownDescription: "The number of who-items of the access provider. Only set if `include_counts` is `true`.",
+						},
+						"what_count": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The number of data object what-items of the access provider. Only set if include_counts is true.",
+							MarkdownDescription: "The number of data object what-items of the access provider. Only set if `include_counts` is `true`.",
+						},
+					},
+				},
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The access providers that match all filters",
+				MarkdownDescription: "The access providers that match all filters",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": dataSourceTimeoutsBlock(ctx),
+		},
+		Description:         "List access providers",
+		MarkdownDescription: "List Raito access providers (grants, masks, filters and purposes), optionally filtered by action, state, category, data source, owner and name. Deleted access providers are never returned.",
+	}
+}
+
+func (a *AccessProvidersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data AccessProvidersDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diagnostics := data.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
+	response.Diagnostics.Append(validateNamePattern(data.Name, path.Root("name"))...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	listFilter := raitoType.AccessProviderFilterInput{}
+
+	if !data.Action.IsNull() {
+		for _, action := range accessProviderActions {
+			if action.String() == data.Action.ValueString() {
+				listFilter.Action = []models.AccessProviderAction{action}
+			}
+		}
+	}
+
+	if !data.State.IsNull() {
+		for _, state := range []models.AccessProviderState{models.AccessProviderStateActive, models.AccessProviderStateInactive} {
+			if state.String() == data.State.ValueString() {
+				listFilter.State = []models.AccessProviderState{state}
+			}
+		}
+	}
+
+	if !data.Category.IsNull() {
+		listFilter.Category = []string{data.Category.ValueString()}
+	}
+
+	if !data.DataSource.IsNull() {
+		listFilter.DataSource = []string{data.DataSource.ValueString()}
+	}
+
+	if !data.Owner.IsNull() {
+		listFilter.Owner = []string{data.Owner.ValueString()}
+	}
+
+	filter := accessProvidersFilter{
+		Action:     data.Action.ValueStringPointer(),
+		State:      data.State.ValueStringPointer(),
+		Category:   data.Category.ValueStringPointer(),
+		DataSource: data.DataSource.ValueStringPointer(),
+		Name:       data.Name.ValueStringPointer(),
+	}
+
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	apChannel := a.client.AccessProvider().ListAccessProviders(cancelCtx, services.WithAccessProviderListFilter(&listFilter))
+
+	accessProviders := make([]attr.Value, 0)
+
+	for apItem := range apChannel {
+		if apItem.HasError() {
+			response.Diagnostics.AddError("Failed to list access providers", apItem.GetError().Error())
+
+			return
+		}
+
+		ap := apItem.GetItem()
+
+		match, err := filter.matches(ap)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("name"), "Invalid name pattern", err.Error())
+
+			return
+		} else if !match {
+			continue
+		}
+
+		accessProvider, diagn := a.accessProviderSummary(ctx, ap, data.IncludeCounts.ValueBool())
+		response.Diagnostics.Append(diagn...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		accessProviders = append(accessProviders, accessProvider)
+	}
+
+	accessProvidersValue, diagn := types.ListValue(types.ObjectType{AttrTypes: accessProvidersItemAttributeTypes}, accessProviders)
+	response.Diagnostics.Append(diagn...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.AccessProviders = accessProvidersValue
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (a *AccessProvidersDataSource) accessProviderSummary(ctx context.Context, ap *raitoType.AccessProvider, includeCounts bool) (_ types.Object, diagnostics diag.Diagnostics) {
+	dataSources := make([]attr.Value, 0, len(ap.SyncData))
+	for i := range ap.SyncData {
+		dataSources = append(dataSources, types.StringValue(ap.SyncData[i].DataSource.Id))
+	}
+
+	dataSourcesValue, diagn := types.SetValue(types.StringType, dataSources)
+	diagnostics.Append(diagn...)
+
+	category := types.StringNull()
+	if ap.Category.Id != "" {
+		category = types.StringValue(ap.Category.Id)
+	}
+
+	whoCount := types.Int64Null()
+	whatCount := types.Int64Null()
+
+	if includeCounts {
+		whoCount, diagn = a.countWhoItems(ctx, ap.Id)
+		diagnostics.Append(diagn...)
+
+		whatCount, diagn = a.countWhatDataObjects(ctx, ap.Id)
+		diagnostics.Append(diagn...)
+	}
+
+	if diagnostics.HasError() {
+		return types.ObjectNull(accessProvidersItemAttributeTypes), diagnostics
+	}
+
+	return types.ObjectValueMust(accessProvidersItemAttributeTypes, map[string]attr.Value{
+		"id":           types.StringValue(ap.Id),
+		"name":         types.StringValue(ap.Name),
+		"action":       types.StringValue(ap.Action.String()),
+		"state":        types.StringValue(ap.State.String()),
+		"category":     category,
+		"data_sources": dataSourcesValue,
+		"who_count":    whoCount,
+		"what_count":   whatCount,
+	}), diagnostics
+}
+
+// countWhoItems counts the who-items of the access provider without converting them to state values.
+func (a *AccessProvidersDataSource) countWhoItems(ctx context.Context, apId string) (_ types.Int64, diagnostics diag.Diagnostics) {
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	var count int64
+
+	for whoItem := range a.client.AccessProvider().GetAccessProviderWhoList(cancelCtx, apId) {
+		if whoItem.HasError() {
+			diagnostics.AddError("Failed to read who-item from access provider", whoItem.GetError().Error())
+
+			return types.Int64Null(), diagnostics
+		}
+
+		count++
+	}
+
+	return types.Int64Value(count), diagnostics
+}
+
+// countWhatDataObjects counts the data object what-items of the access provider without converting them to state values.
+func (a *AccessProvidersDataSource) countWhatDataObjects(ctx context.Context, apId string) (_ types.Int64, diagnostics diag.Diagnostics) {
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	var count int64
+
+	for whatItem := range a.client.AccessProvider().GetAccessProviderWhatDataObjectList(cancelCtx, apId) {
+		if whatItem.HasError() {
+			diagnostics.AddError("Failed to get what data objects", whatItem.GetError().Error())
+
+			return types.Int64Null(), diagnostics
+		}
+
+		count++
+	}
+
+	return types.Int64Value(count), diagnostics
+}
+
+func (a *AccessProvidersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.RaitoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.RaitoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *sdk.RaitoClient, not to be nil.",
+		)
+
+		return
+	}
+
+	a.client = client
+}
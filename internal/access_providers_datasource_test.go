@@ -0,0 +1,174 @@
+package internal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	raitoType "github.com/raito-io/sdk-go/types"
+	"github.com/raito-io/sdk-go/types/models"
+
+	"github.com/raito-io/terraform-provider-raito/internal/utils"
+)
+
+func TestAccessProvidersFilter_Matches(t *testing.T) {
+	accessProvider := &raitoType.AccessProvider{
+		Name:   "Finance read access",
+		Action: models.AccessProviderActionGrant,
+		State:  models.AccessProviderStateActive,
+	}
+
+	deletedAccessProvider := &raitoType.AccessProvider{
+		Name:   "Finance read access",
+		Action: models.AccessProviderActionGrant,
+		State:  models.AccessProviderStateDeleted,
+	}
+
+	tests := []struct {
+		name           string
+		filter         accessProvidersFilter
+		accessProvider *raitoType.AccessProvider
+		want           bool
+		wantErr        bool
+	}{
+		{
+			name:           "no filters",
+			filter:         accessProvidersFilter{},
+			accessProvider: accessProvider,
+			want:           true,
+		},
+		{
+			name:           "deleted access provider",
+			filter:         accessProvidersFilter{},
+			accessProvider: deletedAccessProvider,
+			want:           false,
+		},
+		{
+			name:           "matching action and state",
+			filter:         accessProvidersFilter{Action: utils.Ptr("Grant"), State: utils.Ptr("Active")},
+			accessProvider: accessProvider,
+			want:           true,
+		},
+		{
+			name:           "other action",
+			filter:         accessProvidersFilter{Action: utils.Ptr("Mask")},
+			accessProvider: accessProvider,
+			want:           false,
+		},
+		{
+			name:           "other state",
+			filter:         accessProvidersFilter{State: utils.Ptr("Inactive")},
+			accessProvider: accessProvider,
+			want:           false,
+		},
+		{
+			name:           "other category",
+			filter:         accessProvidersFilter{Category: utils.Ptr("category-id")},
+			accessProvider: accessProvider,
+			want:           false,
+		},
+		{
+			name:           "data source not linked",
+			filter:         accessProvidersFilter{DataSource: utils.Ptr("data-source-id")},
+			accessProvider: accessProvider,
+			want:           false,
+		},
+		{
+			name:           "matching name pattern",
+			filter:         accessProvidersFilter{Name: utils.Ptr("Finance*")},
+			accessProvider: accessProvider,
+			want:           true,
+		},
+		{
+			name:           "non matching name pattern",
+			filter:         accessProvidersFilter{Name: utils.Ptr("Sales*")},
+			accessProvider: accessProvider,
+			want:           false,
+		},
+		{
+			name:           "invalid name pattern",
+			filter:         accessProvidersFilter{Name: utils.Ptr("[")},
+			accessProvider: accessProvider,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filter.matches(tt.accessProvider)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("matches() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccAccessProvidersDataSource(t *testing.T) {
+	testId := gonanoid.Must(8)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: false,
+		PreCheck: func() {
+			AccProviderPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_0_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "raito_datasource" "ds" {
+	name = "Snowflake"
+}
+
+resource "raito_grant" "test" {
+	name        = "tfTestApList-%[1]s"
+	description = "access providers list test"
+	data_source = [
+		{
+			data_source = data.raito_datasource.ds.id
+		}
+	]
+	what_data_objects = [
+		{
+			fullname = "MASTER_DATA.SALES"
+			data_source = data.raito_datasource.ds.id
+		}
+	]
+	who = [
+		{
+			"user": "terraform@raito.io"
+		}
+	]
+}
+
+data "raito_access_providers" "test" {
+	action         = "Grant"
+	state          = "Active"
+	data_source    = data.raito_datasource.ds.id
+	name           = "tfTestApList-%[1]s*"
+	include_counts = true
+
+	depends_on = [raito_grant.test]
+}
+`, testId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.raito_access_providers.test", "access_providers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.raito_access_providers.test", "access_providers.0.id", "raito_grant.test", "id"),
+					resource.TestCheckResourceAttr("data.raito_access_providers.test", "access_providers.0.action", "Grant"),
+					resource.TestCheckResourceAttr("data.raito_access_providers.test", "access_providers.0.state", "Active"),
+					resource.TestCheckResourceAttrPair("data.raito_access_providers.test", "access_providers.0.data_sources.0", "data.raito_datasource.ds", "id"),
+					resource.TestCheckResourceAttr("data.raito_access_providers.test", "access_providers.0.who_count", "1"),
+					resource.TestCheckResourceAttr("data.raito_access_providers.test", "access_providers.0.what_count", "1"),
+				),
+			},
+		},
+	})
+}
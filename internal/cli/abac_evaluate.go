@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/raito-io/terraform-provider-raito/internal/types/abac_expression"
+)
+
+// AbacEvaluateCommand is the name of the subcommand that evaluates abac rules locally.
+const AbacEvaluateCommand = "abac-evaluate"
+
+// AbacEvaluateCase is a single case of a cases file. Expected is the expected result of the rule for the tags, properties and user attributes.
+type AbacEvaluateCase struct {
+	Name       string              `json:"name"`
+	Tags       map[string][]string `json:"tags"`
+	Properties map[string]string   `json:"properties"`
+	User       map[string]string   `json:"user"`
+	Expected   bool                `json:"expected"`
+}
+
+// AbacEvaluate runs the abac-evaluate subcommand with the given arguments.
+// It prints the result of the rule for the given tags, properties and user attributes, or the result of each case if a cases file is given.
+func AbacEvaluate(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet(AbacEvaluateCommand, flag.ContinueOnError)
+	flags.SetOutput(stdout)
+
+	rule := flags.String("rule", "", "json representation of the abac rule, or @<file> to read it from a file")
+	tags := flags.String("tags", "{}", "json object mapping each tag key to a list of values, or @<file> to read it from a file")
+	properties := flags.String("properties", "{}", "json object mapping each property to its value, or @<file> to read it from a file")
+	user := flags.String("user", "{}", "json object mapping each attribute of the user to its value, or @<file> to read it from a file")
+	cases := flags.String("cases", "", "json file with a list of cases (name, tags, properties, user and expected) to evaluate the rule for")
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("parse arguments: %w", err)
+	}
+
+	if *rule == "" {
+		return errors.New("the -rule argument is required")
+	}
+
+	var expression abac_expression.BinaryExpression
+	if err := unmarshalArgument("rule", *rule, &expression); err != nil {
+		return err
+	}
+
+	if errs := expression.Validate(); errs != nil {
+		return fmt.Errorf("invalid abac rule: %w", errs)
+	}
+
+	if *cases != "" {
+		return evaluateCases(&expression, *cases, stdout)
+	}
+
+	evaluateCase := AbacEvaluateCase{}
+
+	if err := unmarshalArgument("tags", *tags, &evaluateCase.Tags); err != nil {
+		return err
+	}
+
+	if err := unmarshalArgument("properties", *properties, &evaluateCase.Properties); err != nil {
+		return err
+	}
+
+	if err := unmarshalArgument("user", *user, &evaluateCase.User); err != nil {
+		return err
+	}
+
+	result, err := expression.EvaluateForUser(evaluateCase.Tags, evaluateCase.Properties, evaluateCase.User)
+	if err != nil {
+		return fmt.Errorf("evaluate abac rule: %w", err)
+	}
+
+	_, err = fmt.Fprintln(stdout, result)
+
+	return err //nolint:wrapcheck
+}
+
+func evaluateCases(expression *abac_expression.BinaryExpression, casesFile string, stdout io.Writer) error {
+	var cases []AbacEvaluateCase
+	if err := unmarshalArgument("cases", "@"+casesFile, &cases); err != nil {
+		return err
+	}
+
+	failed := 0
+
+	for i, evaluateCase := range cases {
+		name := evaluateCase.Name
+		if name == "" {
+			name = fmt.Sprintf("case %d", i)
+		}
+
+		result, err := expression.EvaluateForUser(evaluateCase.Tags, evaluateCase.Properties, evaluateCase.User)
+		if err != nil {
+			return fmt.Errorf("evaluate abac rule: %w", err)
+		}
+
+		status := "PASS"
+		if result != evaluateCase.Expected {
+			status = "FAIL"
+			failed++
+		}
+
+		if _, err := fmt.Fprintf(stdout, "%s\t%s\t(expected %t, got %t)\n", status, name, evaluateCase.Expected, result); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d cases failed", failed, len(cases))
+	}
+
+	return nil
+}
+
+// unmarshalArgument unmarshals the json value of an argument. Values starting with @ are read from the file with the given name.
+func unmarshalArgument(name string, value string, target any) error {
+	data := []byte(value)
+
+	if fileName, isFile := strings.CutPrefix(value, "@"); isFile {
+		var err error
+
+		data, err = os.ReadFile(fileName)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", name, err)
+		}
+	}
+
+	if err := json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("parse %s: %w", name, err)
+	}
+
+	return nil
+}
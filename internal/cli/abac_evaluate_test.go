@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testRule = `{"aggregator":{"operator":"Or","operands":[
+	{"aggregator":{"operator":"And","operands":[{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Finance"}}}}]}},
+	{"comparison":{"operator":"PropertyIn","leftOperand":"type","rightOperand":{"literal":{"stringList":["view"]}}}}
+]}}`
+
+func TestAbacEvaluate(t *testing.T) {
+	var stdout bytes.Buffer
+
+	err := AbacEvaluate([]string{"-rule", testRule, "-tags", `{"department":["Finance"]}`}, &stdout)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if stdout.String() != "true\n" {
+		t.Errorf("unexpected output %q", stdout.String())
+	}
+}
+
+func TestAbacEvaluate_User(t *testing.T) {
+	var stdout bytes.Buffer
+
+	rule := `{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"reference":{"userAttribute":"department"}}}}`
+
+	err := AbacEvaluate([]string{"-rule", rule, "-tags", `{"department":["Finance"]}`, "-user", `{"department":"Finance"}`}, &stdout)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if stdout.String() != "true\n" {
+		t.Errorf("unexpected output %q", stdout.String())
+	}
+}
+
+func TestAbacEvaluate_Cases(t *testing.T) {
+	dir := t.TempDir()
+	rulePath := filepath.Join(dir, "rule.json")
+	casesPath := filepath.Join(dir, "cases.json")
+
+	if err := os.WriteFile(rulePath, []byte(testRule), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := `[
+		{"name": "finance", "tags": {"department": ["Finance"]}, "expected": true},
+		{"name": "view", "properties": {"type": "view"}, "expected": true},
+		{"name": "sales", "tags": {"department": ["Sales"]}, "expected": true}
+	]`
+	if err := os.WriteFile(casesPath, []byte(cases), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout bytes.Buffer
+
+	err := AbacEvaluate([]string{"-rule", "@" + rulePath, "-cases", casesPath}, &stdout)
+	if err == nil || err.Error() != "1 of 3 cases failed" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	expectedOutput := "PASS\tfinance\t(expected true, got true)\nPASS\tview\t(expected true, got true)\nFAIL\tsales\t(expected true, got false)\n"
+	if stdout.String() != expectedOutput {
+		t.Errorf("unexpected output %q", stdout.String())
+	}
+}
+
+func TestAbacEvaluate_InvalidRule(t *testing.T) {
+	err := AbacEvaluate([]string{"-rule", `{"aggregator":{"operator":"Or","operands":[]}}`}, &bytes.Buffer{})
+
+	if err == nil || !strings.Contains(err.Error(), "aggregator.operands: at least one operand is required") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,273 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/raito-io/sdk-go"
+	"github.com/raito-io/sdk-go/services"
+	raitoType "github.com/raito-io/sdk-go/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/utils"
+)
+
+var _ datasource.DataSource = (*DataObjectDataSource)(nil)
+
+type DataObjectDataSourceModel struct {
+	Id                   types.String   `tfsdk:"id"`
+	DataSource           types.String   `tfsdk:"data_source"`
+	FullName             types.String   `tfsdk:"full_name"`
+	Name                 types.String   `tfsdk:"name"`
+	Type                 types.String   `tfsdk:"type"`
+	Parent               types.String   `tfsdk:"parent"`
+	Description          types.String   `tfsdk:"description"`
+	Tags                 types.Map      `tfsdk:"tags"`
+	AvailablePermissions types.Set      `tfsdk:"available_permissions"`
+	Columns              types.List     `tfsdk:"columns"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+type DataObjectDataSource struct {
+	client *sdk.RaitoClient
+}
+
+func NewDataObjectDataSource() datasource.DataSource {
+	return &DataObjectDataSource{}
+}
+
+func (d *DataObjectDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_data_object"
+}
+
+func (d *DataObjectDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The ID of the data object",
+				MarkdownDescription: "The ID of the data object",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("full_name")),
+				},
+			},
+			"data_source": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The ID of the data source of the data object",
+				MarkdownDescription: "The ID of the data source of the data object",
+			},
+			"full_name": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The full name of the data object. Requires data_source to be set.",
+				MarkdownDescription: "The full name of the data object. Requires `data_source` to be set.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("data_source")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The name of the data object",
+				MarkdownDescription: "The name of the data object",
+			},
+			"type": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The type of the data object (e.g. schema, table, column)",
+				MarkdownDescription: "The type of the data object (e.g. `schema`, `table`, `column`)",
+			},
+			"parent": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The ID of the parent data object, if any",
+				MarkdownDescription: "The ID of the parent data object, if any",
+			},
+			"description": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The description of the data object",
+				MarkdownDescription: "The description of the data object",
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.ListType{ElemType: types.StringType},
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The tags of the data object, mapping each tag key to all values of that key",
+				MarkdownDescription: "The tags of the data object, mapping each tag key to all values of that key. This can directly be used as `tags` argument of the `abac_evaluate` function.",
+			},
+			"available_permissions": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The permissions that can be granted on the data object",
+				MarkdownDescription: "The permissions that can be granted on the data object",
+			},
+			"columns": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The names of the columns of the data object",
+				MarkdownDescription: "The names of the columns of the data object. Empty if the data object has no columns.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": dataSourceTimeoutsBlock(ctx),
+		},
+		Description:         "Find a data object by data source and full name, or by ID",
+		MarkdownDescription: "Find a Raito data object by data source and full name, or by ID",
+	}
+}
+
+func (d *DataObjectDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data DataObjectDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diagnostics := data.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
+	id := data.Id.ValueString()
+
+	if data.Id.IsNull() {
+		var err error
+
+		id, err = d.client.DataObject().GetDataObjectIdByName(ctx, data.FullName.ValueString(), data.DataSource.ValueString())
+		if err != nil {
+			response.Diagnostics.AddError("Failed to get data object id", err.Error())
+
+			return
+		}
+	}
+
+	dataObject, err := d.client.DataObject().GetDataObject(ctx, id)
+	if err != nil {
+		response.Diagnostics.AddError("Failed to get data object", err.Error())
+
+		return
+	}
+
+	var parentId *string
+	if dataObject.Parent != nil {
+		parentId = &dataObject.Parent.Id
+	}
+
+	data.Id = types.StringValue(dataObject.Id)
+	data.DataSource = types.StringValue(dataObject.DataSource.Id)
+	data.FullName = types.StringValue(dataObject.FullName)
+	data.Name = types.StringValue(dataObject.Name)
+	data.Type = types.StringValue(dataObject.Type)
+	data.Parent = types.StringPointerValue(parentId)
+	data.Description = types.StringValue(dataObject.Description)
+
+	var diagn diag.Diagnostics
+
+	data.Tags, diagn = dataObjectTagsValue(dataObject)
+	response.Diagnostics.Append(diagn...)
+
+	data.AvailablePermissions, diagn = utils.SliceToStringSet(ctx, dataObject.AvailablePermissions)
+	response.Diagnostics.Append(diagn...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Load the columns of the data object
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	childrenChannel := d.client.DataObject().ListDataObjects(cancelCtx, services.WithDataObjectListFilter(&raitoType.DataObjectFilterInput{
+		Parent: &dataObject.Id,
+		Types:  []string{dataObjectTypeColumn},
+	}))
+
+	columns := make([]attr.Value, 0)
+
+	for child := range childrenChannel {
+		if child.HasError() {
+			response.Diagnostics.AddError("Failed to list columns", child.GetError().Error())
+
+			return
+		}
+
+		columns = append(columns, types.StringValue(child.GetItem().Name))
+	}
+
+	data.Columns, diagn = types.ListValue(types.StringType, columns)
+	response.Diagnostics.Append(diagn...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (d *DataObjectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.RaitoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.RaitoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *sdk.RaitoClient, not to be nil.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
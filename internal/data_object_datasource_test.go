@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDataObjectDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: false,
+		PreCheck: func() {
+			AccProviderPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_0_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "raito_datasource" "ds" {
+	name = "Snowflake"
+}
+
+data "raito_data_object" "table" {
+	data_source = data.raito_datasource.ds.id
+	full_name = "MASTER_DATA.SALES.SPECIALOFFER"
+}
+
+data "raito_data_object" "schema" {
+	id = data.raito_data_object.table.parent
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.raito_data_object.table", "name", "SPECIALOFFER"),
+					resource.TestCheckResourceAttr("data.raito_data_object.table", "type", "table"),
+					resource.TestCheckResourceAttrSet("data.raito_data_object.table", "id"),
+					resource.TestCheckResourceAttrSet("data.raito_data_object.table", "columns.0"),
+					resource.TestCheckResourceAttrSet("data.raito_data_object.table", "available_permissions.0"),
+					resource.TestCheckResourceAttr("data.raito_data_object.schema", "full_name", "MASTER_DATA.SALES"),
+					resource.TestCheckResourceAttr("data.raito_data_object.schema", "type", "schema"),
+					resource.TestCheckResourceAttr("data.raito_data_object.schema", "columns.#", "0"),
+					resource.TestCheckResourceAttrPair("data.raito_data_object.schema", "data_source", "data.raito_datasource.ds", "id"),
+				),
+			},
+		},
+	})
+}
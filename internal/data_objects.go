@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	raitoType "github.com/raito-io/sdk-go/types"
+)
+
+const dataObjectTypeColumn = "column"
+
+// dataObjectTagsValue converts the tags of a data object to a map of each tag key to all values of that key.
+// The result has the same shape as the tags argument of the abac_evaluate function.
+func dataObjectTagsValue(dataObject *raitoType.DataObject) (types.Map, diag.Diagnostics) {
+	tagValues := make(map[string][]attr.Value)
+
+	for _, tag := range dataObject.Tags {
+		if _, found := tagValues[tag.Key]; !found {
+			tagValues[tag.Key] = []attr.Value{}
+		}
+
+		if tag.StringValue != nil {
+			tagValues[tag.Key] = append(tagValues[tag.Key], types.StringValue(*tag.StringValue))
+		}
+	}
+
+	var diagnostics diag.Diagnostics
+
+	elements := make(map[string]attr.Value, len(tagValues))
+
+	for key, values := range tagValues {
+		listValue, listDiagnostics := types.ListValue(types.StringType, values)
+		diagnostics.Append(listDiagnostics...)
+
+		elements[key] = listValue
+	}
+
+	if diagnostics.HasError() {
+		return types.MapNull(types.ListType{ElemType: types.StringType}), diagnostics
+	}
+
+	result, mapDiagnostics := types.MapValue(types.ListType{ElemType: types.StringType}, elements)
+	diagnostics.Append(mapDiagnostics...)
+
+	return result, diagnostics
+}
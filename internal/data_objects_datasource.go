@@ -0,0 +1,339 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	stdpath "path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/raito-io/sdk-go"
+	"github.com/raito-io/sdk-go/services"
+	raitoType "github.com/raito-io/sdk-go/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/utils"
+)
+
+var _ datasource.DataSource = (*DataObjectsDataSource)(nil)
+
+var dataObjectsItemAttributeTypes = map[string]attr.Type{
+	"id":        types.StringType,
+	"name":      types.StringType,
+	"full_name": types.StringType,
+	"type":      types.StringType,
+	"tags":      types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+}
+
+type DataObjectsDataSourceModel struct {
+	DataSource  types.String   `tfsdk:"data_source"`
+	Parent      types.String   `tfsdk:"parent"`
+	Types       types.Set      `tfsdk:"types"`
+	TagKey      types.String   `tfsdk:"tag_key"`
+	TagValue    types.String   `tfsdk:"tag_value"`
+	Name        types.String   `tfsdk:"name"`
+	DataObjects types.List     `tfsdk:"data_objects"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// dataObjectsFilter contains the filters of the raito_data_objects data source that are applied on the provider side.
+type dataObjectsFilter struct {
+	TagKey   *string
+	TagValue *string
+	Name     *string
+}
+
+// validateNamePattern validates the glob pattern of a name filter, so a malformed pattern is reported even if nothing is listed.
+func validateNamePattern(pattern types.String, attributePath path.Path) (diagnostics diag.Diagnostics) {
+	if pattern.IsNull() || pattern.IsUnknown() {
+		return diagnostics
+	}
+
+	if err := utils.ValidateGlob(pattern.ValueString()); err != nil {
+		diagnostics.AddAttributeError(attributePath, "Invalid name pattern", fmt.Sprintf("%q is not a valid glob pattern: %s", pattern.ValueString(), err.Error()))
+	}
+
+	return diagnostics
+}
+
+// matches returns true if the data object passes the tag and name filters.
+func (f *dataObjectsFilter) matches(dataObject *raitoType.DataObject) (bool, error) {
+	if f.Name != nil {
+		match, err := stdpath.Match(*f.Name, dataObject.Name)
+		if err != nil {
+			return false, err
+		}
+
+		if !match {
+			return false, nil
+		}
+	}
+
+	if f.TagKey == nil {
+		return true, nil
+	}
+
+	for _, tag := range dataObject.Tags {
+		if tag.Key != *f.TagKey {
+			continue
+		}
+
+		if f.TagValue == nil || (tag.StringValue != nil && *tag.StringValue == *f.TagValue) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+type DataObjectsDataSource struct {
+	client *sdk.RaitoClient
+}
+
+func NewDataObjectsDataSource() datasource.DataSource {
+	return &DataObjectsDataSource{}
+}
+
+func (d *DataObjectsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_data_objects"
+}
+
+func (d *DataObjectsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data_source": schema.StringAttribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The ID of the data source in which the data objects are searched",
+				MarkdownDescription: "The ID of the data source in which the data objects are searched",
+			},
+			"parent": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The ID of the parent data object. If set, only the direct children of the parent are returned.",
+				MarkdownDescription: "The ID of the parent data object. If set, only the direct children of the parent are returned.",
+			},
+			"types": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "Only return data objects of the given types (e.g. schema, table, column)",
+				MarkdownDescription: "Only return data objects of the given types (e.g. `schema`, `table`, `column`)",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"tag_key": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "Only return data objects that have a tag with the given key",
+				MarkdownDescription: "Only return data objects that have a tag with the given key",
+			},
+			"tag_value": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "Only return data objects that have a tag with the given key and value. Requires tag_key to be set.",
+				MarkdownDescription: "Only return data objects that have a tag with the given key and value. Requires `tag_key` to be set.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("tag_key")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "Only return data objects of which the name matches the given glob pattern (e.g. CUSTOMER_*)",
+				MarkdownDescription: "Only return data objects of which the name matches the given glob pattern (e.g. `CUSTOMER_*`)",
+			},
+			"data_objects": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the data object",
+							MarkdownDescription: "The ID of the data object",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the data object",
+							MarkdownDescription: "The name of the data object",
+						},
+						"full_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The full name of the data object",
+							MarkdownDescription: "The full name of the data object",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The type of the data object",
+							MarkdownDescription: "The type of the data object",
+						},
+						"tags": schema.MapAttribute{
+							ElementType:         types.ListType{ElemType: types.StringType},
+							Computed:            true,
+							Description:         "The tags of the data object, mapping each tag key to all values of that key",
+							MarkdownDescription: "The tags of the data object, mapping each tag key to all values of that key",
+						},
+					},
+				},
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The data objects that match all filters",
+				MarkdownDescription: "The data objects that match all filters",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": dataSourceTimeoutsBlock(ctx),
+		},
+		Description:         "Search data objects in a data source",
+		MarkdownDescription: "Search Raito data objects in a data source, optionally filtered by parent, type, tag and name.",
+	}
+}
+
+func (d *DataObjectsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data DataObjectsDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diagnostics := data.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
+	response.Diagnostics.Append(validateNamePattern(data.Name, path.Root("name"))...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	listFilter := raitoType.DataObjectFilterInput{
+		DataSources: []string{data.DataSource.ValueString()},
+		Parent:      data.Parent.ValueStringPointer(),
+	}
+
+	if !data.Types.IsNull() {
+		doTypes, diagn := utils.StringSetToSlice(ctx, data.Types)
+		response.Diagnostics.Append(diagn...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		listFilter.Types = doTypes
+	}
+
+	filter := dataObjectsFilter{
+		TagKey:   data.TagKey.ValueStringPointer(),
+		TagValue: data.TagValue.ValueStringPointer(),
+		Name:     data.Name.ValueStringPointer(),
+	}
+
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	doChannel := d.client.DataObject().ListDataObjects(cancelCtx, services.WithDataObjectListFilter(&listFilter))
+
+	dataObjects := make([]attr.Value, 0)
+
+	for doItem := range doChannel {
+		if doItem.HasError() {
+			response.Diagnostics.AddError("Failed to list data objects", doItem.GetError().Error())
+
+			return
+		}
+
+		dataObject := doItem.GetItem()
+
+		match, err := filter.matches(dataObject)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("name"), "Invalid name pattern", err.Error())
+
+			return
+		} else if !match {
+			continue
+		}
+
+		tags, diagn := dataObjectTagsValue(dataObject)
+		response.Diagnostics.Append(diagn...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		dataObjects = append(dataObjects, types.ObjectValueMust(dataObjectsItemAttributeTypes, map[string]attr.Value{
+			"id":        types.StringValue(dataObject.Id),
+			"name":      types.StringValue(dataObject.Name),
+			"full_name": types.StringValue(dataObject.FullName),
+			"type":      types.StringValue(dataObject.Type),
+			"tags":      tags,
+		}))
+	}
+
+	dataObjectsValue, diagn := types.ListValue(types.ObjectType{AttrTypes: dataObjectsItemAttributeTypes}, dataObjects)
+	response.Diagnostics.Append(diagn...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.DataObjects = dataObjectsValue
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (d *DataObjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.RaitoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.RaitoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *sdk.RaitoClient, not to be nil.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,158 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	raitoType "github.com/raito-io/sdk-go/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/utils"
+)
+
+func TestDataObjectsFilter_Matches(t *testing.T) {
+	dataObject := &raitoType.DataObject{
+		Name: "CUSTOMER_ADDRESS",
+		Tags: []raitoType.Tag{
+			{Key: "pii", StringValue: utils.Ptr("address")},
+			{Key: "deprecated"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		filter  dataObjectsFilter
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "no filters",
+			filter: dataObjectsFilter{},
+			want:   true,
+		},
+		{
+			name:   "matching name pattern",
+			filter: dataObjectsFilter{Name: utils.Ptr("CUSTOMER_*")},
+			want:   true,
+		},
+		{
+			name:   "non matching name pattern",
+			filter: dataObjectsFilter{Name: utils.Ptr("ORDER_*")},
+			want:   false,
+		},
+		{
+			name:    "invalid name pattern",
+			filter:  dataObjectsFilter{Name: utils.Ptr("[")},
+			wantErr: true,
+		},
+		{
+			name:   "tag key only",
+			filter: dataObjectsFilter{TagKey: utils.Ptr("deprecated")},
+			want:   true,
+		},
+		{
+			name:   "tag key and value",
+			filter: dataObjectsFilter{TagKey: utils.Ptr("pii"), TagValue: utils.Ptr("address")},
+			want:   true,
+		},
+		{
+			name:   "tag key with other value",
+			filter: dataObjectsFilter{TagKey: utils.Ptr("pii"), TagValue: utils.Ptr("email")},
+			want:   false,
+		},
+		{
+			name:   "missing tag key",
+			filter: dataObjectsFilter{TagKey: utils.Ptr("owner")},
+			want:   false,
+		},
+		{
+			name:   "name and tag",
+			filter: dataObjectsFilter{Name: utils.Ptr("CUSTOMER_*"), TagKey: utils.Ptr("owner")},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filter.matches(dataObject)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("matches() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateNamePattern(t *testing.T) {
+	tests := []struct {
+		pattern types.String
+		wantErr bool
+	}{
+		{pattern: types.StringNull()},
+		{pattern: types.StringUnknown()},
+		{pattern: types.StringValue("CUSTOMER_*")},
+		{pattern: types.StringValue("["), wantErr: true},
+		{pattern: types.StringValue("CUSTOMER_[a-"), wantErr: true},
+		{pattern: types.StringValue("CUST_*["), wantErr: true},
+		{pattern: types.StringValue(`CUSTOMER_\`), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern.String(), func(t *testing.T) {
+			diagnostics := validateNamePattern(tt.pattern, path.Root("name"))
+			if diagnostics.HasError() != tt.wantErr {
+				t.Errorf("validateNamePattern() = %v, wantErr %v", diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccDataObjectsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: false,
+		PreCheck: func() {
+			AccProviderPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_0_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "raito_datasource" "ds" {
+	name = "Snowflake"
+}
+
+data "raito_data_object" "schema" {
+	data_source = data.raito_datasource.ds.id
+	full_name = "MASTER_DATA.SALES"
+}
+
+data "raito_data_objects" "tables" {
+	data_source = data.raito_datasource.ds.id
+	parent = data.raito_data_object.schema.id
+	types = ["table"]
+}
+
+data "raito_data_objects" "special_offer" {
+	data_source = data.raito_datasource.ds.id
+	parent = data.raito_data_object.schema.id
+	name = "SPECIAL*"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.raito_data_objects.tables", "data_objects.0.id"),
+					resource.TestCheckResourceAttr("data.raito_data_objects.tables", "data_objects.0.type", "table"),
+					resource.TestCheckResourceAttr("data.raito_data_objects.special_offer", "data_objects.#", "1"),
+					resource.TestCheckResourceAttr("data.raito_data_objects.special_offer", "data_objects.0.full_name", "MASTER_DATA.SALES.SPECIALOFFER"),
+				),
+			},
+		},
+	})
+}
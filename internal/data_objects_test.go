@@ -0,0 +1,37 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	raitoType "github.com/raito-io/sdk-go/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/utils"
+)
+
+func TestDataObjectTagsValue(t *testing.T) {
+	dataObject := &raitoType.DataObject{
+		Tags: []raitoType.Tag{
+			{Key: "pii", StringValue: utils.Ptr("email")},
+			{Key: "pii", StringValue: utils.Ptr("phone")},
+			{Key: "owner", StringValue: utils.Ptr("sales")},
+			{Key: "deprecated"},
+		},
+	}
+
+	got, diagnostics := dataObjectTagsValue(dataObject)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+
+	expected := types.MapValueMust(types.ListType{ElemType: types.StringType}, map[string]attr.Value{
+		"pii":        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("email"), types.StringValue("phone")}),
+		"owner":      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("sales")}),
+		"deprecated": types.ListValueMust(types.StringType, []attr.Value{}),
+	})
+
+	if !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -15,14 +16,15 @@ import (
 var _ datasource.DataSource = (*DataSourceDataSource)(nil)
 
 type DataSourceDataSourceModel struct {
-	Id                  types.String `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	Description         types.String `tfsdk:"description"`
-	SyncMethod          types.String `tfsdk:"sync_method"`
-	Parent              types.String `tfsdk:"parent"`
-	NativeIdentityStore types.String `tfsdk:"native_identity_store"`
-	IdentityStores      types.Set    `tfsdk:"identity_stores"`
-	Owners              types.Set    `tfsdk:"owners"`
+	Id                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	SyncMethod          types.String   `tfsdk:"sync_method"`
+	Parent              types.String   `tfsdk:"parent"`
+	NativeIdentityStore types.String   `tfsdk:"native_identity_store"`
+	IdentityStores      types.Set      `tfsdk:"identity_stores"`
+	Owners              types.Set      `tfsdk:"owners"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type DataSourceDataSource struct {
@@ -37,7 +39,7 @@ func (d *DataSourceDataSource) Metadata(_ context.Context, request datasource.Me
 	response.TypeName = request.ProviderTypeName + "_datasource"
 }
 
-func (d *DataSourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+func (d *DataSourceDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -108,6 +110,9 @@ func (d *DataSourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				MarkdownDescription: "The IDs of the owners of the data source",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": dataSourceTimeoutsBlock(ctx),
+		},
 		Description:         "Find a data source based on the name",
 		MarkdownDescription: "Find a Raito [Data Source](https://docs.raito.io/docs/cloud/datasources) based on the name",
 	}
@@ -122,6 +127,16 @@ func (d *DataSourceDataSource) Read(ctx context.Context, request datasource.Read
 		return
 	}
 
+	readTimeout, diagnostics := data.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
 	name := data.Name.ValueString()
 
 	cancelCtx, cancelFunc := context.WithCancel(ctx)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -27,14 +28,15 @@ import (
 var _ resource.Resource = (*DataSourceResource)(nil)
 
 type DataSourceResourceModel struct {
-	Id                  types.String `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	Description         types.String `tfsdk:"description"`
-	SyncMethod          types.String `tfsdk:"sync_method"`
-	Parent              types.String `tfsdk:"parent"`
-	NativeIdentityStore types.String `tfsdk:"native_identity_store"`
-	IdentityStores      types.Set    `tfsdk:"identity_stores"`
-	Owners              types.Set    `tfsdk:"owners"`
+	Id                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	SyncMethod          types.String   `tfsdk:"sync_method"`
+	Parent              types.String   `tfsdk:"parent"`
+	NativeIdentityStore types.String   `tfsdk:"native_identity_store"`
+	IdentityStores      types.Set      `tfsdk:"identity_stores"`
+	Owners              types.Set      `tfsdk:"owners"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *DataSourceResourceModel) ToDataSourceInput() raitoType.DataSourceInput {
@@ -58,7 +60,7 @@ func (d *DataSourceResource) Metadata(_ context.Context, request resource.Metada
 	response.TypeName = request.ProviderTypeName + "_datasource"
 }
 
-func (d *DataSourceResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (d *DataSourceResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -140,6 +142,9 @@ func (d *DataSourceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				MarkdownDescription: "The IDs of the owners of the data source",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 		Description:         "The data source resource",
 		MarkdownDescription: "The resource for representing a Raito [Data Source](https://docs.raito.io/docs/cloud/datasources).",
 		Version:             1,
@@ -156,6 +161,16 @@ func (d *DataSourceResource) Create(ctx context.Context, request resource.Create
 		return
 	}
 
+	createTimeout, diagnostics := data.Timeouts.Create(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "create", createTimeout, &response.Diagnostics)
+	defer done()
+
 	// Create data source
 	dataSourceResult, err := d.client.DataSource().CreateDataSource(ctx, data.ToDataSourceInput())
 	if err != nil {
@@ -254,6 +269,16 @@ func (d *DataSourceResource) Read(ctx context.Context, request resource.ReadRequ
 		return
 	}
 
+	readTimeout, diagnostics := stateData.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
 	ds, err := d.client.DataSource().GetDataSource(ctx, stateData.Id.ValueString())
 	if err != nil {
 		var notFoundErr *raitoType.ErrNotFound
@@ -307,6 +332,7 @@ func (d *DataSourceResource) Read(ctx context.Context, request resource.ReadRequ
 		Parent:              types.StringPointerValue(parentId),
 		NativeIdentityStore: types.StringPointerValue(nativeIs),
 		IdentityStores:      isAttr,
+		Timeouts:            stateData.Timeouts,
 	}
 
 	owners, diagn := getOwners(ctx, stateData.Id.ValueString(), d.client)
@@ -331,6 +357,16 @@ func (d *DataSourceResource) Update(ctx context.Context, request resource.Update
 		return
 	}
 
+	updateTimeout, diagnostics := data.Timeouts.Update(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "update", updateTimeout, &response.Diagnostics)
+	defer done()
+
 	// Update data source
 	_, err := d.client.DataSource().UpdateDataSource(ctx, data.Id.ValueString(), data.ToDataSourceInput())
 	if err != nil {
@@ -424,6 +460,16 @@ func (d *DataSourceResource) Delete(ctx context.Context, request resource.Delete
 		return
 	}
 
+	deleteTimeout, diagnostics := data.Timeouts.Delete(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "delete", deleteTimeout, &response.Diagnostics)
+	defer done()
+
 	currentUser, err := d.client.User().GetCurrentUser(ctx)
 	if err != nil {
 		response.Diagnostics.AddError("Failed to get current user", err.Error())
@@ -470,18 +516,18 @@ func (d *DataSourceResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*sdk.RaitoClient)
+	providerData, ok := req.ProviderData.(*RaitoResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.RaitoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *internal.RaitoResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	if client == nil {
+	if providerData == nil || providerData.Client == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
 			"Expected *sdk.RaitoClient, not to be nil.",
@@ -490,7 +536,7 @@ func (d *DataSourceResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 func (d *DataSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
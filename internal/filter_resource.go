@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"slices"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +16,7 @@ import (
 	raitoType "github.com/raito-io/sdk-go/types"
 	"github.com/raito-io/sdk-go/types/models"
 
+	"github.com/raito-io/terraform-provider-raito/internal/types/abac_expression"
 	"github.com/raito-io/terraform-provider-raito/internal/utils"
 )
 
@@ -23,15 +24,20 @@ var _ resource.Resource = (*FilterResource)(nil)
 
 type FilterResourceModel struct {
 	// AccessProviderResourceModel properties. This has to be duplicated because of https://github.com/hashicorp/terraform-plugin-framework/issues/242
-	Id                types.String         `tfsdk:"id"`
-	Name              types.String         `tfsdk:"name"`
-	Description       types.String         `tfsdk:"description"`
-	State             types.String         `tfsdk:"state"`
-	Who               types.Set            `tfsdk:"who"`
-	Owners            types.Set            `tfsdk:"owners"`
-	WhoAbacRule       jsontypes.Normalized `tfsdk:"who_abac_rule"`
-	WhoLocked         types.Bool           `tfsdk:"who_locked"`
-	InheritanceLocked types.Bool           `tfsdk:"inheritance_locked"`
+	Id                types.String                        `tfsdk:"id"`
+	Name              types.String                        `tfsdk:"name"`
+	Description       types.String                        `tfsdk:"description"`
+	State             types.String                        `tfsdk:"state"`
+	Who               types.Set                           `tfsdk:"who"`
+	Owners            types.Set                           `tfsdk:"owners"`
+	WhoAbacRule       abac_expression.AbacRuleValue       `tfsdk:"who_abac_rule"`
+	WhoAbac           types.Object                        `tfsdk:"who_abac"`
+	WhoAbacExpression abac_expression.AbacExpressionValue `tfsdk:"who_abac_expression"`
+	WhoLocked         types.Bool                          `tfsdk:"who_locked"`
+	InheritanceLocked types.Bool                          `tfsdk:"inheritance_locked"`
+	Locks             types.Set                           `tfsdk:"locks"`
+	LockReason        types.String                        `tfsdk:"lock_reason"`
+	Timeouts          timeouts.Value                      `tfsdk:"timeouts"`
 
 	// FilterResourceModel properties
 	DataSource   types.String `tfsdk:"data_source"`
@@ -49,8 +55,13 @@ func (f *FilterResourceModel) GetAccessProviderResourceModel() *AccessProviderRe
 		Who:               f.Who,
 		Owners:            f.Owners,
 		WhoAbacRule:       f.WhoAbacRule,
+		WhoAbac:           f.WhoAbac,
+		WhoAbacExpression: f.WhoAbacExpression,
 		WhoLocked:         f.WhoLocked,
 		InheritanceLocked: f.InheritanceLocked,
+		Locks:             f.Locks,
+		LockReason:        f.LockReason,
+		Timeouts:          f.Timeouts,
 	}
 }
 
@@ -62,8 +73,13 @@ func (f *FilterResourceModel) SetAccessProviderResourceModel(ap *AccessProviderR
 	f.Who = ap.Who
 	f.Owners = ap.Owners
 	f.WhoAbacRule = ap.WhoAbacRule
+	f.WhoAbac = ap.WhoAbac
+	f.WhoAbacExpression = ap.WhoAbacExpression
 	f.WhoLocked = ap.WhoLocked
 	f.InheritanceLocked = ap.InheritanceLocked
+	f.Locks = ap.Locks
+	f.LockReason = ap.LockReason
+	f.Timeouts = ap.Timeouts
 }
 
 func (f *FilterResourceModel) ToAccessProviderInput(ctx context.Context, client *sdk.RaitoClient, result *raitoType.AccessProviderInput) diag.Diagnostics {
@@ -151,6 +167,7 @@ type FilterResource struct {
 func NewFilterResource() resource.Resource {
 	return &FilterResource{
 		AccessProviderResource: AccessProviderResource[FilterResourceModel, *FilterResourceModel]{
+			resourceType: "raito_filter",
 			readHooks: []ReadHook[FilterResourceModel, *FilterResourceModel]{
 				readFilterResourceTable,
 			},
@@ -207,7 +224,10 @@ func (f *FilterResource) Schema(ctx context.Context, request resource.SchemaRequ
 	}
 
 	response.Schema = schema.Schema{
-		Attributes:          attributes,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 		Description:         "The filter access control resource",
 		MarkdownDescription: "The resource for representing a Raito [Row-level Filter](https://docs.raito.io/docs/cloud/access_management/row_filters) access control.",
 		Version:             1,
@@ -0,0 +1,191 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/types/fullname"
+)
+
+var (
+	_ function.Function = (*FullnameJoinFunction)(nil)
+	_ function.Function = (*FullnameSplitFunction)(nil)
+	_ function.Function = (*FullnameParentFunction)(nil)
+	_ function.Function = (*FullnameQuoteFunction)(nil)
+)
+
+const fullnameQuotingDescription = "Parts that are empty or contain a dot or a double quote are enclosed in double quotes, in which double quotes are doubled, e.g. `MASTER_DATA.\"my.schema\"`."
+
+type FullnameJoinFunction struct{}
+
+func NewFullnameJoinFunction() function.Function {
+	return &FullnameJoinFunction{}
+}
+
+func (f *FullnameJoinFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "fullname_join"
+}
+
+func (f *FullnameJoinFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Join parts to a full name",
+		Description:         "Returns the dot-separated full name of a data object consisting of the given parts, quoting parts where needed.",
+		MarkdownDescription: "Returns the dot-separated full name of a data object consisting of the given parts. " + fullnameQuotingDescription,
+		VariadicParameter: function.StringParameter{
+			Name:                "parts",
+			Description:         "The unquoted parts of the full name, e.g. the database, schema and table name",
+			MarkdownDescription: "The unquoted parts of the full name, e.g. the database, schema and table name",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FullnameJoinFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var parts []string
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &parts))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(parts) == 0 {
+		response.Error = function.NewArgumentFuncError(0, "At least one part is required")
+
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, fullname.Join(parts...)))
+}
+
+type FullnameSplitFunction struct{}
+
+func NewFullnameSplitFunction() function.Function {
+	return &FullnameSplitFunction{}
+}
+
+func (f *FullnameSplitFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "fullname_split"
+}
+
+func (f *FullnameSplitFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Split a full name in its parts",
+		Description:         "Returns the unquoted parts of the dot-separated full name of a data object.",
+		MarkdownDescription: "Returns the unquoted parts of the dot-separated full name of a data object. " + fullnameQuotingDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				Description:         "The full name of the data object",
+				MarkdownDescription: "The full name of the data object",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *FullnameSplitFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var name string
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &name))
+
+	if response.Error != nil {
+		return
+	}
+
+	parts, err := fullname.Split(name)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, "Invalid full name: "+err.Error())
+
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, parts))
+}
+
+type FullnameParentFunction struct{}
+
+func NewFullnameParentFunction() function.Function {
+	return &FullnameParentFunction{}
+}
+
+func (f *FullnameParentFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "fullname_parent"
+}
+
+func (f *FullnameParentFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Get the parent of a full name",
+		Description:         "Returns the full name of the parent of a data object, e.g. the schema of a table. An empty string is returned if the full name has a single part.",
+		MarkdownDescription: "Returns the full name of the parent of a data object, e.g. the schema of a table. An empty string is returned if the full name has a single part. " + fullnameQuotingDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				Description:         "The full name of the data object",
+				MarkdownDescription: "The full name of the data object",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FullnameParentFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var name string
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &name))
+
+	if response.Error != nil {
+		return
+	}
+
+	parent, err := fullname.Parent(name)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, "Invalid full name: "+err.Error())
+
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, parent))
+}
+
+type FullnameQuoteFunction struct{}
+
+func NewFullnameQuoteFunction() function.Function {
+	return &FullnameQuoteFunction{}
+}
+
+func (f *FullnameQuoteFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "fullname_quote"
+}
+
+func (f *FullnameQuoteFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Quote a part of a full name",
+		Description:         "Returns the part as it should appear in a full name, quoting it where needed.",
+		MarkdownDescription: "Returns the part as it should appear in a full name. " + fullnameQuotingDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "part",
+				Description:         "The unquoted part of the full name",
+				MarkdownDescription: "The unquoted part of the full name",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FullnameQuoteFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var part string
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &part))
+
+	if response.Error != nil {
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, fullname.Quote(part)))
+}
@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestFullnameFunctions(t *testing.T) {
+	join := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	NewFullnameJoinFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.TupleValueMust([]attr.Type{types.StringType, types.StringType}, []attr.Value{types.StringValue("MASTER_DATA"), types.StringValue("my.schema")}),
+		}),
+	}, &join)
+
+	if join.Error != nil || !join.Result.Value().Equal(types.StringValue(`MASTER_DATA."my.schema"`)) {
+		t.Fatalf("unexpected fullname_join result %s, %v", join.Result.Value(), join.Error)
+	}
+
+	split := function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+
+	NewFullnameSplitFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{join.Result.Value()}),
+	}, &split)
+
+	expected := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("MASTER_DATA"), types.StringValue("my.schema")})
+	if split.Error != nil || !split.Result.Value().Equal(expected) {
+		t.Errorf("unexpected fullname_split result %s, %v", split.Result.Value(), split.Error)
+	}
+
+	parent := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	NewFullnameParentFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(`MASTER_DATA."my`)}),
+	}, &parent)
+
+	if parent.Error == nil {
+		t.Errorf("expected an error for an unterminated quote, got %s", parent.Result.Value())
+	}
+}
+
+// TestAccFullnameFunctions_RaitoFullNames checks the quoting convention against the full names of all schemas, tables and views returned by Raito Cloud.
+// The last part of each full name must be the name of the data object and joining the parts must result in the same full name.
+func TestAccFullnameFunctions_RaitoFullNames(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: false,
+		PreCheck: func() {
+			AccProviderPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "raito_datasource" "ds" {
+	name = "Snowflake"
+}
+
+data "raito_data_objects" "all" {
+	data_source = data.raito_datasource.ds.id
+	types = ["schema", "table", "view"]
+}
+
+locals {
+	parts = { for do in data.raito_data_objects.all.data_objects : do.full_name => {
+		name  = do.name
+		parts = provider::raito::fullname_split(do.full_name)
+	} }
+}
+
+output "has_data_objects" {
+	value = length(local.parts) > 0
+}
+
+output "split_matches_name" {
+	value = alltrue([for full_name, do in local.parts : do.parts[length(do.parts) - 1] == do.name])
+}
+
+output "join_matches_full_name" {
+	value = alltrue([for full_name, do in local.parts : provider::raito::fullname_join(do.parts...) == full_name])
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("has_data_objects", "true"),
+					resource.TestCheckOutput("split_matches_name", "true"),
+					resource.TestCheckOutput("join_matches_full_name", "true"),
+				),
+			},
+		},
+	})
+}
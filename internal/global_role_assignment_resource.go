@@ -3,8 +3,10 @@ package internal
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,14 +23,20 @@ import (
 )
 
 var _ resource.Resource = (*GlobalRoleAssignmentResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*GlobalRoleAssignmentResource)(nil)
 
 const roleIdSuffix = "Role"
+
+// globalRoleScope is the scope of the roles that can be assigned globally, as opposed to roles that are assigned on a resource (e.g. OwnerRole).
+const globalRoleScope = "Global"
 const _separator = "#"
 
 type GlobalRoleAssignmentModel struct {
-	Id   types.String `tfsdk:"id"`
-	Role types.String `tfsdk:"role"`
-	User types.String `tfsdk:"user"`
+	Id       types.String   `tfsdk:"id"`
+	Role     types.String   `tfsdk:"role"`
+	User     types.String   `tfsdk:"user"`
+	Group    types.String   `tfsdk:"group"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func roleId(roleName string) string {
@@ -39,16 +47,44 @@ func (m *GlobalRoleAssignmentModel) GetRoleId() string {
 	return roleId(m.Role.ValueString())
 }
 
+// GetAssigneeId returns the ID of the user or group the global role is assigned to.
+func (m *GlobalRoleAssignmentModel) GetAssigneeId() string {
+	if !m.User.IsNull() {
+		return m.User.ValueString()
+	}
+
+	return m.Group.ValueString()
+}
+
+// GetUniqueId returns the ID of the global role assignment.
+// User assignments keep the original <role>#<user> format, group assignments use <role>#group:<group>.
+func (m *GlobalRoleAssignmentModel) GetUniqueId() string {
+	if !m.User.IsNull() {
+		return _generateUniqueId(m.Role.ValueString(), m.User.ValueString())
+	}
+
+	return _generateUniqueId(m.Role.ValueString(), _groupPrefix(m.Group.ValueString()))
+}
+
 func _generateUniqueId(role, user string) string {
 	return role + _separator + user
 }
 
-func _getRoleAndUserFromId(id string) (role, user string) {
+func _getRoleAndAssigneeFromId(id string) (role, user, group string, err error) {
 	parts := strings.SplitN(id, _separator, 2)
+	if len(parts) != 2 {
+		return "", "", "", fmt.Errorf("invalid global role assignment id %q: expected format <role>%[2]s<user> or <role>%[2]s%[3]s<group>", id, _separator, _groupPrefix(""))
+	}
+
 	role = parts[0]
-	user = parts[1]
 
-	return
+	if groupId, isGroup := strings.CutPrefix(parts[1], _groupPrefix("")); isGroup {
+		group = groupId
+	} else {
+		user = strings.TrimPrefix(parts[1], _userPrefix(""))
+	}
+
+	return role, user, group, nil
 }
 
 type GlobalRoleAssignmentResource struct {
@@ -79,26 +115,40 @@ func (g *GlobalRoleAssignmentResource) Schema(ctx context.Context, request resou
 				Computed:            false,
 				Sensitive:           false,
 				Description:         "Global role name",
-				MarkdownDescription: "Global role name",
-				Validators: []validator.String{
-					stringvalidator.OneOf("Admin", "Creator", "Observer", "Integrator", "AccessCreator"),
-				},
+				MarkdownDescription: "Global role name (e.g. `Admin`, `Creator`, `Observer`, `Integrator` or `AccessCreator`). The role is validated against the global roles available in Raito Cloud. Roles that can only be assigned on a resource (e.g. `Owner`) are not accepted.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"user": schema.StringAttribute{
-				Required:            true,
-				Optional:            false,
+				Required:            false,
+				Optional:            true,
 				Computed:            false,
 				Sensitive:           false,
 				Description:         "User id",
-				MarkdownDescription: "User id",
+				MarkdownDescription: "User id. Exactly one of `user` or `group` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("group")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "Group id",
+				MarkdownDescription: "Group id. Exactly one of `user` or `group` must be set.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 		Description:         "Global Role Assignment",
 		MarkdownDescription: "Global Role Assignment",
 		Version:             1,
@@ -114,14 +164,24 @@ func (g *GlobalRoleAssignmentResource) Create(ctx context.Context, request resou
 		return
 	}
 
-	_, err := g.client.Role().AssignGlobalRole(ctx, data.GetRoleId(), data.User.ValueString())
+	createTimeout, diagnostics := data.Timeouts.Create(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "create", createTimeout, &response.Diagnostics)
+	defer done()
+
+	_, err := g.client.Role().AssignGlobalRole(ctx, data.GetRoleId(), data.GetAssigneeId())
 	if err != nil {
 		response.Diagnostics.AddError("failed to assign global role", err.Error())
 
 		return
 	}
 
-	data.Id = types.StringValue(_generateUniqueId(data.Role.ValueString(), data.User.ValueString()))
+	data.Id = types.StringValue(data.GetUniqueId())
 
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 
@@ -139,18 +199,41 @@ func (g *GlobalRoleAssignmentResource) Read(ctx context.Context, request resourc
 		return
 	}
 
+	readTimeout, diagnostics := stateData.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
 	// Read role assignment
-	roleName, userId := _getRoleAndUserFromId(stateData.Id.ValueString())
+	roleName, userId, groupId, err := _getRoleAndAssigneeFromId(stateData.Id.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Failed to parse global role assignment id", err.Error())
+
+		return
+	}
 
 	cancelCtx, cancel := context.WithCancel(ctx)
 
 	defer cancel()
 
-	roleAssignmentChannel := g.client.Role().ListRoleAssignments(cancelCtx, services.WithRoleAssignmentListFilter(
-		&types2.RoleAssignmentFilterInput{
-			Role: utils.Ptr(roleId(roleName)),
-			User: &userId,
-		}))
+	filter := types2.RoleAssignmentFilterInput{
+		Role: utils.Ptr(roleId(roleName)),
+	}
+
+	if userId != "" {
+		filter.User = &userId
+	}
+
+	if groupId != "" {
+		filter.Group = &groupId
+	}
+
+	roleAssignmentChannel := g.client.Role().ListRoleAssignments(cancelCtx, services.WithRoleAssignmentListFilter(&filter))
 
 	var ra *types2.RoleAssignment
 
@@ -159,12 +242,21 @@ func (g *GlobalRoleAssignmentResource) Read(ctx context.Context, request resourc
 			response.Diagnostics.AddError("Failed to list role assignment", roleAssignment.GetError().Error())
 
 			return
-		} else if ra != nil {
+		} else if roleAssignment.GetItem() == nil {
+			continue
+		}
+
+		// Only direct assignments to the group are relevant.
+		if groupId != "" {
+			if to, isGroup := roleAssignment.GetItem().To.(*types2.RoleAssignmentToGroup); !isGroup || to.Id != groupId {
+				continue
+			}
+		}
+
+		if ra != nil {
 			response.Diagnostics.AddError("Multiple role assignment found", "Multiple role assignment found")
 
 			return
-		} else if roleAssignment.GetItem() == nil {
-			continue
 		}
 
 		ra = roleAssignment.GetItem()
@@ -176,7 +268,19 @@ func (g *GlobalRoleAssignmentResource) Read(ctx context.Context, request resourc
 		return
 	}
 
-	stateData.User = types.StringValue(ra.To.(*types2.RoleAssignmentToUser).Id)
+	switch to := ra.To.(type) {
+	case *types2.RoleAssignmentToUser:
+		stateData.User = types.StringValue(to.Id)
+		stateData.Group = types.StringNull()
+	case *types2.RoleAssignmentToGroup:
+		stateData.User = types.StringNull()
+		stateData.Group = types.StringValue(to.Id)
+	default:
+		response.Diagnostics.AddError("Unexpected role assignment type", fmt.Sprintf("Unexpected role assignment type %T", to))
+
+		return
+	}
+
 	raRoleId := ra.Role.Role.GetId()
 	stateData.Role = types.StringValue(strings.TrimSuffix(raRoleId, roleIdSuffix))
 
@@ -184,7 +288,25 @@ func (g *GlobalRoleAssignmentResource) Read(ctx context.Context, request resourc
 }
 
 func (g *GlobalRoleAssignmentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
-	response.Diagnostics.AddError("Not able to update role assignment", "Not able to update role assignment")
+	var planData, stateData GlobalRoleAssignmentModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Role, user and group require a replacement, so only the timeouts can be updated in place.
+	if !planData.Role.Equal(stateData.Role) || !planData.User.Equal(stateData.User) || !planData.Group.Equal(stateData.Group) {
+		response.Diagnostics.AddError("Not able to update role assignment", "Not able to update role assignment")
+
+		return
+	}
+
+	stateData.Timeouts = planData.Timeouts
+
+	response.Diagnostics.Append(response.State.Set(ctx, stateData)...)
 }
 
 func (g *GlobalRoleAssignmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -196,7 +318,17 @@ func (g *GlobalRoleAssignmentResource) Delete(ctx context.Context, request resou
 		return
 	}
 
-	_, err := g.client.Role().UnassignGlobalRole(ctx, data.GetRoleId(), data.User.ValueString())
+	deleteTimeout, diagnostics := data.Timeouts.Delete(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "delete", deleteTimeout, &response.Diagnostics)
+	defer done()
+
+	_, err := g.client.Role().UnassignGlobalRole(ctx, data.GetRoleId(), data.GetAssigneeId())
 	if err != nil {
 		response.Diagnostics.AddError("failed to unassign global role", err.Error())
 
@@ -206,22 +338,75 @@ func (g *GlobalRoleAssignmentResource) Delete(ctx context.Context, request resou
 	response.State.RemoveResource(ctx)
 }
 
+func (g *GlobalRoleAssignmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || g.client == nil {
+		return
+	}
+
+	var data GlobalRoleAssignmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Role.IsUnknown() || data.Role.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateData GlobalRoleAssignmentModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+		// The role of an existing assignment was already validated when it was created.
+		if resp.Diagnostics.HasError() || data.Role.Equal(stateData.Role) {
+			return
+		}
+	}
+
+	// Validate the role against the global roles available in Raito Cloud, so new roles do not require a provider release.
+	// Roles that can only be assigned on a resource are not accepted.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var roleNames []string
+
+	for role := range g.client.Role().ListRoles(cancelCtx) {
+		if role.HasError() {
+			resp.Diagnostics.AddError("Failed to list roles", role.GetError().Error())
+
+			return
+		}
+
+		if !slices.ContainsFunc(role.GetItem().Scopes, func(scope string) bool { return strings.EqualFold(scope, globalRoleScope) }) {
+			continue
+		}
+
+		roleName := strings.TrimSuffix(role.GetItem().Id, roleIdSuffix)
+		if roleName == data.Role.ValueString() {
+			return
+		}
+
+		roleNames = append(roleNames, roleName)
+	}
+
+	resp.Diagnostics.AddAttributeError(path.Root("role"), "Invalid role", fmt.Sprintf("Global role %q does not exist. Available global roles are: %s.", data.Role.ValueString(), strings.Join(roleNames, ", ")))
+}
+
 func (g *GlobalRoleAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*sdk.RaitoClient)
+	providerData, ok := req.ProviderData.(*RaitoResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.RaitoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *internal.RaitoResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	if client == nil {
+	if providerData == nil || providerData.Client == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
 			"Expected *sdk.RaitoClient, not to be nil.",
@@ -230,7 +415,7 @@ func (g *GlobalRoleAssignmentResource) Configure(_ context.Context, req resource
 		return
 	}
 
-	g.client = client
+	g.client = providerData.Client
 }
 
 func (g *GlobalRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
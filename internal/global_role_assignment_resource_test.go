@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -10,6 +11,54 @@ import (
 	gonanoid "github.com/matoous/go-nanoid/v2"
 )
 
+func TestGlobalRoleAssignmentId(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		wantRole  string
+		wantUser  string
+		wantGroup string
+		wantErr   bool
+	}{
+		{
+			name:     "user id without prefix",
+			id:       _generateUniqueId("Admin", "user1"),
+			wantRole: "Admin",
+			wantUser: "user1",
+		},
+		{
+			name:     "user id with prefix",
+			id:       "Observer#user:user1",
+			wantRole: "Observer",
+			wantUser: "user1",
+		},
+		{
+			name:      "group id",
+			id:        _generateUniqueId("Observer", _groupPrefix("group1")),
+			wantRole:  "Observer",
+			wantGroup: "group1",
+		},
+		{
+			name:    "missing assignee",
+			id:      "Admin",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, user, group, err := _getRoleAndAssigneeFromId(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("_getRoleAndAssigneeFromId() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if role != tt.wantRole || user != tt.wantUser || group != tt.wantGroup {
+				t.Errorf("_getRoleAndAssigneeFromId() = (%q, %q, %q), want (%q, %q, %q)", role, user, group, tt.wantRole, tt.wantUser, tt.wantGroup)
+			}
+		})
+	}
+}
+
 func TestAccGlobalRoleAssignmentResource(t *testing.T) {
 	testId := gonanoid.Must(8)
 
@@ -152,4 +201,118 @@ resource "raito_global_role_assignment" "gra2" {
 			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		})
 	})
+
+	t.Run("group", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest: false,
+			PreCheck: func() {
+				AccProviderPreCheck(t)
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_0_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+%[2]s
+
+data "raito_identitystore" "raito" {
+	name = "Raito"
+}
+
+resource "raito_group" "g1" {
+	name = "gra-tfTestGroup-%[1]s"
+	identity_store = data.raito_identitystore.raito.id
+}
+
+resource "raito_global_role_assignment" "gra1" {
+	role = "Observer"
+	group = raito_group.g1.id
+}
+`, testId, providerConfig),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("raito_global_role_assignment.gra1", "role", "Observer"),
+						resource.TestCheckResourceAttrPair("raito_global_role_assignment.gra1", "group", "raito_group.g1", "id"),
+						resource.TestCheckNoResourceAttr("raito_global_role_assignment.gra1", "user"),
+						resource.TestCheckResourceAttrWith("raito_global_role_assignment.gra1", "id", func(value string) error {
+							if !strings.HasPrefix(value, "Observer#group:") {
+								return fmt.Errorf("expected id to start with Observer#group: but is %q", value)
+							}
+
+							return nil
+						}),
+					),
+				},
+				{
+					ResourceName:      "raito_global_role_assignment.gra1",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		})
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest: false,
+			PreCheck: func() {
+				AccProviderPreCheck(t)
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_0_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+%[2]s
+
+resource "raito_user" "u1" {
+	name = "gra-tfTestUser-%[1]s"
+	email = "gra-test-user-%[1]s@raito.io"
+	raito_user = true
+}
+
+resource "raito_global_role_assignment" "gra1" {
+	role = "NotARole"
+	user = raito_user.u1.id
+}
+`, testId, providerConfig),
+					ExpectError: regexp.MustCompile(`Global role "NotARole" does not exist`),
+				},
+			},
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		})
+	})
+	t.Run("resource role", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest: false,
+			PreCheck: func() {
+				AccProviderPreCheck(t)
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_0_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+%[2]s
+
+resource "raito_user" "u1" {
+	name = "gra-tfTestUser-%[1]s"
+	email = "gra-test-user-%[1]s@raito.io"
+	raito_user = true
+}
+
+resource "raito_global_role_assignment" "gra1" {
+	role = "Owner"
+	user = raito_user.u1.id
+}
+`, testId, providerConfig),
+					ExpectError: regexp.MustCompile(`Global role "Owner" does not exist`),
+				},
+			},
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		})
+	})
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -16,17 +17,18 @@ import (
 var _ datasource.DataSource = (*GrantCategoryDataSource)(nil)
 
 type GrantCategoryDataSourceModel struct {
-	Id                       types.String `tfsdk:"id"`
-	Name                     types.String `tfsdk:"name"`
-	Description              types.String `tfsdk:"description"`
-	IsSystem                 types.Bool   `tfsdk:"is_system"`
-	IsDefault                types.Bool   `tfsdk:"is_default"`
-	CanCreate                types.Bool   `tfsdk:"can_create"`
-	AllowDuplicateNames      types.Bool   `tfsdk:"allow_duplicate_names"`
-	MultiDataSource          types.Bool   `tfsdk:"multi_data_source"`
-	DefaultTypePerDataSource types.Set    `tfsdk:"default_type_per_data_source"`
-	AllowedWhoItems          types.Object `tfsdk:"allowed_who_items"`
-	AllowedWhatItems         types.Object `tfsdk:"allowed_what_items"`
+	Id                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Description              types.String   `tfsdk:"description"`
+	IsSystem                 types.Bool     `tfsdk:"is_system"`
+	IsDefault                types.Bool     `tfsdk:"is_default"`
+	CanCreate                types.Bool     `tfsdk:"can_create"`
+	AllowDuplicateNames      types.Bool     `tfsdk:"allow_duplicate_names"`
+	MultiDataSource          types.Bool     `tfsdk:"multi_data_source"`
+	DefaultTypePerDataSource types.Set      `tfsdk:"default_type_per_data_source"`
+	AllowedWhoItems          types.Object   `tfsdk:"allowed_who_items"`
+	AllowedWhatItems         types.Object   `tfsdk:"allowed_what_items"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
 }
 
 type GrantCategoryDataSource struct {
@@ -41,7 +43,7 @@ func (g *GrantCategoryDataSource) Metadata(_ context.Context, request datasource
 	response.TypeName = request.ProviderTypeName + "_grant_category"
 }
 
-func (g *GrantCategoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+func (g *GrantCategoryDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -206,6 +208,9 @@ func (g *GrantCategoryDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				MarkdownDescription: "Allowed WHAT items for the grant category",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": dataSourceTimeoutsBlock(ctx),
+		},
 		Description:         "Find a grant category by name",
 		MarkdownDescription: "Find a grant category by name",
 	}
@@ -220,6 +225,16 @@ func (g *GrantCategoryDataSource) Read(ctx context.Context, request datasource.R
 		return
 	}
 
+	readTimeout, diagnostics := data.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
 	name := data.Name.ValueString()
 
 	grantCategories, err := g.client.GrantCategory().ListGrantCategories(ctx)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -26,18 +27,19 @@ import (
 var _ resource.Resource = (*GrantCategoryResource)(nil)
 
 type GrantCategoryResourceModel struct {
-	Id                       types.String `tfsdk:"id"`
-	Name                     types.String `tfsdk:"name"`
-	Description              types.String `tfsdk:"description"`
-	Icon                     types.String `tfsdk:"icon"`
-	IsSystem                 types.Bool   `tfsdk:"is_system"`
-	IsDefault                types.Bool   `tfsdk:"is_default"`
-	CanCreate                types.Bool   `tfsdk:"can_create"`
-	AllowDuplicateNames      types.Bool   `tfsdk:"allow_duplicate_names"`
-	MultiDataSource          types.Bool   `tfsdk:"multi_data_source"`
-	DefaultTypePerDataSource types.Set    `tfsdk:"default_type_per_data_source"`
-	AllowedWhoItems          types.Object `tfsdk:"allowed_who_items"`
-	AllowedWhatItems         types.Object `tfsdk:"allowed_what_items"`
+	Id                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Description              types.String   `tfsdk:"description"`
+	Icon                     types.String   `tfsdk:"icon"`
+	IsSystem                 types.Bool     `tfsdk:"is_system"`
+	IsDefault                types.Bool     `tfsdk:"is_default"`
+	CanCreate                types.Bool     `tfsdk:"can_create"`
+	AllowDuplicateNames      types.Bool     `tfsdk:"allow_duplicate_names"`
+	MultiDataSource          types.Bool     `tfsdk:"multi_data_source"`
+	DefaultTypePerDataSource types.Set      `tfsdk:"default_type_per_data_source"`
+	AllowedWhoItems          types.Object   `tfsdk:"allowed_who_items"`
+	AllowedWhatItems         types.Object   `tfsdk:"allowed_what_items"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *GrantCategoryResourceModel) ToGrantCategoryInput() raitoType.GrantCategoryInput {
@@ -306,6 +308,9 @@ func (g *GrantCategoryResource) Schema(ctx context.Context, request resource.Sch
 				)),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 		Description:         "The grant category resource allows you to manage grant categories in Raito.",
 		MarkdownDescription: "The grant category resource allows you to manage grant categories in Raito.",
 		Version:             1,
@@ -322,6 +327,16 @@ func (g *GrantCategoryResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
+	createTimeout, diagnostics := data.Timeouts.Create(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "create", createTimeout, &response.Diagnostics)
+	defer done()
+
 	grantCategoryResult, err := g.client.GrantCategory().CreateGrantCategory(ctx, data.ToGrantCategoryInput())
 	if err != nil {
 		response.Diagnostics.AddError("Failed to create grant category", err.Error())
@@ -351,6 +366,16 @@ func (g *GrantCategoryResource) Read(ctx context.Context, request resource.ReadR
 		return
 	}
 
+	readTimeout, diagnostics := stateData.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
 	category, err := g.client.GrantCategory().GetGrantCategory(ctx, stateData.Id.ValueString())
 	if err != nil {
 		var notFoundErr *raitoType.ErrNotFound
@@ -382,6 +407,16 @@ func (g *GrantCategoryResource) Update(ctx context.Context, request resource.Upd
 		return
 	}
 
+	updateTimeout, diagnostics := data.Timeouts.Update(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "update", updateTimeout, &response.Diagnostics)
+	defer done()
+
 	// Update grant category
 	gc, err := g.client.GrantCategory().UpdateGrantCategory(ctx, data.Id.ValueString(), data.ToGrantCategoryInput())
 	if err != nil {
@@ -409,6 +444,16 @@ func (g *GrantCategoryResource) Delete(ctx context.Context, request resource.Del
 		return
 	}
 
+	deleteTimeout, diagnostics := data.Timeouts.Delete(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "delete", deleteTimeout, &response.Diagnostics)
+	defer done()
+
 	err := g.client.GrantCategory().DeleteGrantCategory(ctx, data.Id.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError("Failed to delete grant category", err.Error())
@@ -424,17 +469,17 @@ func (g *GrantCategoryResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*sdk.RaitoClient)
+	providerData, ok := req.ProviderData.(*RaitoResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.RaitoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *internal.RaitoResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	if client == nil {
+	if providerData == nil || providerData.Client == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
 			"Expected *sdk.RaitoClient, not to be nil.",
@@ -443,7 +488,7 @@ func (g *GrantCategoryResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	g.client = client
+	g.client = providerData.Client
 }
 func (g *GrantCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
@@ -6,7 +6,7 @@ import (
 	"slices"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -31,15 +31,20 @@ var _ resource.Resource = (*GrantResource)(nil)
 
 type GrantResourceModel struct {
 	// AccessProviderResourceModel properties. This has to be duplicated because of https://github.com/hashicorp/terraform-plugin-framework/issues/242
-	Id                types.String         `tfsdk:"id"`
-	Name              types.String         `tfsdk:"name"`
-	Description       types.String         `tfsdk:"description"`
-	State             types.String         `tfsdk:"state"`
-	Who               types.Set            `tfsdk:"who"`
-	Owners            types.Set            `tfsdk:"owners"`
-	WhoAbacRule       jsontypes.Normalized `tfsdk:"who_abac_rule"`
-	WhoLocked         types.Bool           `tfsdk:"who_locked"`
-	InheritanceLocked types.Bool           `tfsdk:"inheritance_locked"`
+	Id                types.String                        `tfsdk:"id"`
+	Name              types.String                        `tfsdk:"name"`
+	Description       types.String                        `tfsdk:"description"`
+	State             types.String                        `tfsdk:"state"`
+	Who               types.Set                           `tfsdk:"who"`
+	Owners            types.Set                           `tfsdk:"owners"`
+	WhoAbacRule       abac_expression.AbacRuleValue       `tfsdk:"who_abac_rule"`
+	WhoAbac           types.Object                        `tfsdk:"who_abac"`
+	WhoAbacExpression abac_expression.AbacExpressionValue `tfsdk:"who_abac_expression"`
+	WhoLocked         types.Bool                          `tfsdk:"who_locked"`
+	InheritanceLocked types.Bool                          `tfsdk:"inheritance_locked"`
+	Locks             types.Set                           `tfsdk:"locks"`
+	LockReason        types.String                        `tfsdk:"lock_reason"`
+	Timeouts          timeouts.Value                      `tfsdk:"timeouts"`
 
 	// GrantResourceModel properties.
 	Category        types.String `tfsdk:"category"`
@@ -58,8 +63,13 @@ func (m *GrantResourceModel) GetAccessProviderResourceModel() *AccessProviderRes
 		Who:               m.Who,
 		Owners:            m.Owners,
 		WhoAbacRule:       m.WhoAbacRule,
+		WhoAbac:           m.WhoAbac,
+		WhoAbacExpression: m.WhoAbacExpression,
 		WhoLocked:         m.WhoLocked,
 		InheritanceLocked: m.InheritanceLocked,
+		Locks:             m.Locks,
+		LockReason:        m.LockReason,
+		Timeouts:          m.Timeouts,
 	}
 }
 
@@ -71,8 +81,13 @@ func (m *GrantResourceModel) SetAccessProviderResourceModel(ap *AccessProviderRe
 	m.Who = ap.Who
 	m.Owners = ap.Owners
 	m.WhoAbacRule = ap.WhoAbacRule
+	m.WhoAbac = ap.WhoAbac
+	m.WhoAbacExpression = ap.WhoAbacExpression
 	m.WhoLocked = ap.WhoLocked
 	m.InheritanceLocked = ap.InheritanceLocked
+	m.Locks = ap.Locks
+	m.LockReason = ap.LockReason
+	m.Timeouts = ap.Timeouts
 }
 
 func (m *GrantResourceModel) ToAccessProviderInput(ctx context.Context, client *sdk.RaitoClient, result *raitoType.AccessProviderInput) diag.Diagnostics {
@@ -290,16 +305,14 @@ func (m *GrantResourceModel) abacWhatToAccessProviderInput(ctx context.Context,
 		scope = append(scope, id)
 	}
 
-	jsonRule := attributes["rule"].(jsontypes.Normalized)
+	abacRule, abacDiagnostics := whatAbacRuleExpression(ctx, attributes)
+	diagnostics.Append(abacDiagnostics...)
 
-	var abacRule abac_expression.BinaryExpression
-	diagnostics.Append(jsonRule.Unmarshal(&abacRule)...)
-
-	if diagnostics.HasError() {
+	if diagnostics.HasError() || abacRule == nil {
 		return diagnostics
 	}
 
-	abacInput, err := abacRule.ToGqlInput()
+	abacInput, err := abacRuleToGqlInput(abacRule)
 	if err != nil {
 		diagnostics.AddError("Failed to convert abac rule to gql input", err.Error())
 
@@ -325,7 +338,9 @@ func (m *GrantResourceModel) abacWhatFromAccessProvider(ctx context.Context, cli
 		"permissions":        types.SetType{ElemType: types.StringType},
 		"global_permissions": types.SetType{ElemType: types.StringType},
 		"scope":              types.SetType{ElemType: scopeType},
-		"rule":               jsontypes.NormalizedType{},
+		"rule":               abac_expression.AbacRuleType{},
+		"abac":               types.ObjectType{AttrTypes: abacRuleAttributeTypes},
+		"rule_expression":    abac_expression.AbacExpressionType{},
 	}
 
 	permissions, pDiagnostics := utils.SliceToStringSet(ctx, ap.WhatAbacRule.Permissions)
@@ -351,7 +366,12 @@ func (m *GrantResourceModel) abacWhatFromAccessProvider(ctx context.Context, cli
 		return types.ObjectNull(objectTypes), diagnostics
 	}
 
-	abacRule := jsontypes.NewNormalizedPointerValue(ap.WhatAbacRule.RuleJson)
+	abacRule, abac, ruleExpression, abacDiagnostics := whatAbacRuleFromServer(ctx, m.WhatAbacRule, serverAbacRule{Rule: ap.WhatAbacRule.Rule, RuleJson: ap.WhatAbacRule.RuleJson})
+	diagnostics.Append(abacDiagnostics...)
+
+	if diagnostics.HasError() {
+		return types.ObjectNull(objectTypes), diagnostics
+	}
 
 	var scopeItems []attr.Value //nolint:prealloc
 
@@ -383,6 +403,8 @@ func (m *GrantResourceModel) abacWhatFromAccessProvider(ctx context.Context, cli
 		"permissions":        permissions,
 		"global_permissions": globalPermissions,
 		"rule":               abacRule,
+		"abac":               abac,
+		"rule_expression":    ruleExpression,
 		"scope":              scope,
 	})
 
@@ -402,6 +424,7 @@ type GrantResource struct {
 func NewGrantResource() resource.Resource {
 	return &GrantResource{
 		AccessProviderResource[GrantResourceModel, *GrantResourceModel]{
+			resourceType:      "raito_grant",
 			readHooks:         []ReadHook[GrantResourceModel, *GrantResourceModel]{readGrantWhatItems},
 			validationHooks:   []ValidationHook[GrantResourceModel, *GrantResourceModel]{validateGrantWhatItems},
 			planModifierHooks: []PlanModifierHook[GrantResourceModel, *GrantResourceModel]{grantModifyPlan},
@@ -413,7 +436,7 @@ func (g *GrantResource) Metadata(_ context.Context, request resource.MetadataReq
 	response.TypeName = request.ProviderTypeName + "_grant"
 }
 
-func (g *GrantResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (g *GrantResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	attributes := g.schema("grant")
 	attributes["category"] = schema.StringAttribute{
 		Required:            false,
@@ -599,13 +622,27 @@ func (g *GrantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				})),
 			},
 			"rule": schema.StringAttribute{
-				CustomType:          jsontypes.NormalizedType{},
-				Required:            true,
-				Optional:            false,
+				CustomType:          abac_expression.AbacRuleType{},
+				Required:            false,
+				Optional:            true,
 				Computed:            false,
 				Sensitive:           false,
-				Description:         "json representation of the abac rule",
-				MarkdownDescription: "json representation of the abac rule",
+				Description:         "json representation of the abac rule. Exactly one of rule, abac or rule_expression must be set.",
+				MarkdownDescription: "json representation of the abac rule. Exactly one of `rule`, `abac` or `rule_expression` must be set.",
+				Default:             nil,
+			},
+			"abac": abacRuleSchemaAttribute(
+				"Structured representation of the abac rule. Exactly one of rule, abac or rule_expression must be set.",
+				"Structured representation of the abac rule. The rule matches if all conditions of at least one `any_of` item match. Exactly one of `rule`, `abac` or `rule_expression` must be set.",
+			),
+			"rule_expression": schema.StringAttribute{
+				CustomType:          abac_expression.AbacExpressionType{},
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The abac rule, written in the abac expression language. Exactly one of rule, abac or rule_expression must be set.",
+				MarkdownDescription: "The abac rule, written in the abac expression language (e.g. `has_tag(\"department\", \"Finance\") and not contains_tag(\"pii\", \"x\")`). See the abac guide for the syntax. Exactly one of `rule`, `abac` or `rule_expression` must be set.",
 				Default:             nil,
 			},
 		},
@@ -626,7 +663,10 @@ func (g *GrantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 
 	response.Schema = schema.Schema{
-		Attributes:          attributes,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 		Description:         "Grant access control resource",
 		MarkdownDescription: "The resource for representing a Raito [Grant](https://docs.raito.io/docs/cloud/access_management/grants) access control.",
 		Version:             1,
@@ -635,87 +675,88 @@ func (g *GrantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 
 func readGrantWhatItems(ctx context.Context, client *sdk.RaitoClient, data *GrantResourceModel) (diagnostics diag.Diagnostics) {
 	if !data.WhatDataObjects.IsNull() {
-		cancelCtx, cancelFunc := context.WithCancel(ctx)
-		defer cancelFunc()
+		whatDataObject, whatDiagnostics := readWhatDataObjects(ctx, client, data.Id.ValueString())
+		diagnostics.Append(whatDiagnostics...)
 
-		whatItemsChannel := client.AccessProvider().GetAccessProviderWhatDataObjectList(cancelCtx, data.Id.ValueString())
+		if diagnostics.HasError() {
+			return diagnostics
+		}
 
-		stateWhatItems := make([]attr.Value, 0)
+		data.WhatDataObjects = whatDataObject
+	}
 
-		for whatItem := range whatItemsChannel {
-			if whatItem.HasError() {
-				diagnostics.AddError("Failed to get what data objects", whatItem.GetError().Error())
+	return diagnostics
+}
 
-				return diagnostics
-			}
+var whatDataObjectAttributeTypes = map[string]attr.Type{
+	"fullname":    types.StringType,
+	"data_source": types.StringType,
+	"permissions": types.SetType{
+		ElemType: types.StringType,
+	},
+	"global_permissions": types.SetType{
+		ElemType: types.StringType,
+	},
+}
 
-			what := whatItem.GetItem()
+// readWhatDataObjects returns the data objects in the what-list of the access provider, with their permissions.
+func readWhatDataObjects(ctx context.Context, client *sdk.RaitoClient, apId string) (_ types.Set, diagnostics diag.Diagnostics) {
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
 
-			var id *string
-			var dataSourceId *string
+	whatItemsChannel := client.AccessProvider().GetAccessProviderWhatDataObjectList(cancelCtx, apId)
 
-			if what.DataObject != nil {
-				id = &what.DataObject.FullName
-				dataSourceId = &what.DataObject.DataSource.Id
-			} else {
-				diagnostics.AddError("Invalid what data object", "Received data object is nil")
+	stateWhatItems := make([]attr.Value, 0)
 
-				continue
-			}
+	for whatItem := range whatItemsChannel {
+		if whatItem.HasError() {
+			diagnostics.AddError("Failed to get what data objects", whatItem.GetError().Error())
 
-			permissions := make([]attr.Value, 0, len(what.Permissions))
-			for _, p := range what.Permissions {
-				permissions = append(permissions, types.StringPointerValue(p))
-			}
+			return types.SetNull(types.ObjectType{AttrTypes: whatDataObjectAttributeTypes}), diagnostics
+		}
 
-			globalPermissions := make([]attr.Value, 0, len(what.GlobalPermissions))
-			for _, p := range what.GlobalPermissions {
-				globalPermissions = append(globalPermissions, types.StringValue(strings.ToUpper(*p)))
-			}
+		what := whatItem.GetItem()
 
-			stateWhatItems = append(stateWhatItems, types.ObjectValueMust(map[string]attr.Type{
-				"fullname":    types.StringType,
-				"data_source": types.StringType,
-				"permissions": types.SetType{
-					ElemType: types.StringType,
-				},
-				"global_permissions": types.SetType{
-					ElemType: types.StringType,
-				},
-			}, map[string]attr.Value{
-				"fullname":           types.StringPointerValue(id),
-				"data_source":        types.StringPointerValue(dataSourceId),
-				"permissions":        types.SetValueMust(types.StringType, permissions),
-				"global_permissions": types.SetValueMust(types.StringType, globalPermissions),
-			}))
-		}
+		var id *string
+		var dataSourceId *string
 
-		whatDataObject, whatDiag := types.SetValue(types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"fullname":    types.StringType,
-				"data_source": types.StringType,
-				"permissions": types.SetType{
-					ElemType: types.StringType,
-				},
-				"global_permissions": types.SetType{
-					ElemType: types.StringType,
-				},
-			},
-		}, stateWhatItems)
+		if what.DataObject != nil {
+			id = &what.DataObject.FullName
+			dataSourceId = &what.DataObject.DataSource.Id
+		} else {
+			diagnostics.AddError("Invalid what data object", "Received data object is nil")
 
-		diagnostics.Append(whatDiag...)
+			continue
+		}
 
-		if diagnostics.HasError() {
-			return diagnostics
+		permissions := make([]attr.Value, 0, len(what.Permissions))
+		for _, p := range what.Permissions {
+			permissions = append(permissions, types.StringPointerValue(p))
 		}
 
-		data.WhatDataObjects = whatDataObject
+		globalPermissions := make([]attr.Value, 0, len(what.GlobalPermissions))
+		for _, p := range what.GlobalPermissions {
+			globalPermissions = append(globalPermissions, types.StringValue(strings.ToUpper(*p)))
+		}
+
+		stateWhatItems = append(stateWhatItems, types.ObjectValueMust(whatDataObjectAttributeTypes, map[string]attr.Value{
+			"fullname":           types.StringPointerValue(id),
+			"data_source":        types.StringPointerValue(dataSourceId),
+			"permissions":        types.SetValueMust(types.StringType, permissions),
+			"global_permissions": types.SetValueMust(types.StringType, globalPermissions),
+		}))
 	}
 
-	return diagnostics
+	whatDataObject, whatDiag := types.SetValue(types.ObjectType{
+		AttrTypes: whatDataObjectAttributeTypes,
+	}, stateWhatItems)
+
+	diagnostics.Append(whatDiag...)
+
+	return whatDataObject, diagnostics
 }
 
-func validateGrantWhatItems(_ context.Context, data *GrantResourceModel) (diagnostics diag.Diagnostics) {
+func validateGrantWhatItems(ctx context.Context, data *GrantResourceModel) (diagnostics diag.Diagnostics) {
 	if !data.WhatDataObjects.IsNull() && !data.WhatAbacRule.IsNull() {
 		diagnostics.AddError("Cannot set both what_data_objects and what_abac_rule", "Grant Resource cannot have both what_data_objects and what_abac_rule")
 	}
@@ -724,6 +765,8 @@ func validateGrantWhatItems(_ context.Context, data *GrantResourceModel) (diagno
 		diagnostics.AddError("What lock should be true", "What data objects or what abac rule is set, so what lock should be true")
 	}
 
+	diagnostics.Append(validateWhatAbacRule(ctx, data.WhatAbacRule)...)
+
 	return diagnostics
 }
 
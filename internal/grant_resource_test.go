@@ -600,6 +600,89 @@ resource "raito_grant" "who_abac_grant" {
 						resource.TestCheckResourceAttr("raito_grant.who_abac_grant", "what_locked", "true"),
 					),
 				},
+				{
+					Config: providerConfig + `
+data "raito_datasource" "ds" {
+    name = "Snowflake"
+}
+
+resource "raito_grant" "who_abac_grant" {
+	name        = "tfTestGrant"
+    description = "test description"
+	data_source = [
+		{  
+			data_source = data.raito_datasource.ds.id
+			type = "role"
+		}
+	]
+	what_data_objects = [
+		{
+			fullname = "MASTER_DATA.SALES"
+			data_source = data.raito_datasource.ds.id
+		}
+	]
+	who_abac = {
+		any_of = [
+			{
+				all_of = [
+					{
+						has_tag = {
+							key   = "Test"
+							value = "test"
+						}
+					}
+				]
+			}
+		]
+	}
+	inheritance_locked = true
+}
+`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("raito_grant.who_abac_grant", "name", "tfTestGrant"),
+						resource.TestCheckNoResourceAttr("raito_grant.who_abac_grant", "who"),
+						resource.TestCheckNoResourceAttr("raito_grant.who_abac_grant", "who_abac_rule"),
+						resource.TestCheckResourceAttr("raito_grant.who_abac_grant", "who_abac.any_of.#", "1"),
+						resource.TestCheckResourceAttr("raito_grant.who_abac_grant", "who_abac.any_of.0.all_of.#", "1"),
+						resource.TestCheckResourceAttr("raito_grant.who_abac_grant", "who_abac.any_of.0.all_of.0.has_tag.key", "Test"),
+						resource.TestCheckResourceAttr("raito_grant.who_abac_grant", "who_abac.any_of.0.all_of.0.has_tag.value", "test"),
+						resource.TestCheckResourceAttr("raito_grant.who_abac_grant", "who_locked", "true"),
+					),
+				},
+				{
+					Config: providerConfig + `
+data "raito_datasource" "ds" {
+    name = "Snowflake"
+}
+
+resource "raito_grant" "who_abac_grant" {
+	name        = "tfTestGrant"
+    description = "test description"
+	data_source = [
+		{  
+			data_source = data.raito_datasource.ds.id
+			type = "role"
+		}
+	]
+	what_data_objects = [
+		{
+			fullname = "MASTER_DATA.SALES"
+			data_source = data.raito_datasource.ds.id
+		}
+	]
+	who_abac_expression = "has_tag(\"Test\", \"test\") or property_in(\"department\", [\"Finance\"])"
+	inheritance_locked = true
+}
+`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("raito_grant.who_abac_grant", "name", "tfTestGrant"),
+						resource.TestCheckNoResourceAttr("raito_grant.who_abac_grant", "who"),
+						resource.TestCheckNoResourceAttr("raito_grant.who_abac_grant", "who_abac_rule"),
+						resource.TestCheckNoResourceAttr("raito_grant.who_abac_grant", "who_abac"),
+						resource.TestCheckResourceAttr("raito_grant.who_abac_grant", "who_abac_expression", "has_tag(\"Test\", \"test\") or property_in(\"department\", [\"Finance\"])"),
+						resource.TestCheckResourceAttr("raito_grant.who_abac_grant", "who_locked", "true"),
+					),
+				},
 			},
 		})
 	})
@@ -0,0 +1,234 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/raito-io/sdk-go"
+	"github.com/raito-io/sdk-go/services"
+	raitoType "github.com/raito-io/sdk-go/types"
+)
+
+var _ datasource.DataSource = (*GroupDataSource)(nil)
+
+type GroupDataSourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	IdentityStore types.String   `tfsdk:"identity_store"`
+	ExternalId    types.String   `tfsdk:"external_id"`
+	DisplayName   types.String   `tfsdk:"display_name"`
+	Description   types.String   `tfsdk:"description"`
+	MemberCount   types.Int64    `tfsdk:"member_count"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type GroupDataSource struct {
+	client *sdk.RaitoClient
+}
+
+func NewGroupDataSource() datasource.DataSource {
+	return &GroupDataSource{}
+}
+
+func (g *GroupDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_group"
+}
+
+func (g *GroupDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The ID of the requested group",
+				MarkdownDescription: "The ID of the requested group",
+			},
+			"name": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The name of the requested group. Requires identity_store to be set.",
+				MarkdownDescription: "The name of the requested group. Requires `identity_store` to be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("external_id")),
+					stringvalidator.AlsoRequires(path.MatchRoot("identity_store")),
+				},
+			},
+			"identity_store": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The ID of the identity store in which the group is defined. Required if name is set. Can be combined with external_id to look up a group of which the external ID is not unique across identity stores.",
+				MarkdownDescription: "The ID of the identity store in which the group is defined. Required if `name` is set. Can be combined with `external_id` to look up a group of which the external ID is not unique across identity stores.",
+			},
+			"external_id": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The external ID of the requested group, as known in the identity store",
+				MarkdownDescription: "The external ID of the requested group, as known in the identity store",
+			},
+			"display_name": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The display name of the requested group",
+				MarkdownDescription: "The display name of the requested group",
+			},
+			"description": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The description of the requested group",
+				MarkdownDescription: "The description of the requested group",
+			},
+			"member_count": schema.Int64Attribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The number of direct members (users and groups) of the requested group",
+				MarkdownDescription: "The number of direct members (users and groups) of the requested group",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": dataSourceTimeoutsBlock(ctx),
+		},
+		Description:         "Find a group by name and identity store, or by external ID",
+		MarkdownDescription: "Find a Raito [Group](https://docs.raito.io/docs/cloud/identities) by name and identity store, or by external ID",
+	}
+}
+
+func (g *GroupDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data GroupDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diagnostics := data.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	filter := raitoType.GroupFilterInput{}
+
+	if !data.Name.IsNull() {
+		filter.Search = data.Name.ValueStringPointer()
+	}
+
+	groupChan := g.client.Group().ListGroups(cancelCtx, services.WithGroupListFilter(&filter))
+
+	var group *raitoType.Group
+
+	for groupItem := range groupChan {
+		if groupItem.HasError() {
+			response.Diagnostics.AddError("Failed to list groups", groupItem.GetError().Error())
+
+			return
+		}
+
+		item := groupItem.GetItem()
+
+		if !data.ExternalId.IsNull() {
+			if item.ExternalId == nil || *item.ExternalId != data.ExternalId.ValueString() {
+				continue
+			}
+		} else if item.Name != data.Name.ValueString() {
+			continue
+		}
+
+		if !data.IdentityStore.IsNull() && !groupInIdentityStore(item, data.IdentityStore.ValueString()) {
+			continue
+		}
+
+		if group != nil {
+			switch {
+			case data.ExternalId.IsNull():
+				response.Diagnostics.AddError("Multiple groups found", fmt.Sprintf("Multiple groups named %q exist in the identity store. Use external_id to select a single group.", data.Name.ValueString()))
+			case data.IdentityStore.IsNull():
+				response.Diagnostics.AddError("Multiple groups found", fmt.Sprintf("Multiple groups have external ID %q. Set identity_store to select the group of a single identity store.", data.ExternalId.ValueString()))
+			default:
+				response.Diagnostics.AddError("Multiple groups found", fmt.Sprintf("Multiple groups in the identity store have external ID %q.", data.ExternalId.ValueString()))
+			}
+
+			return
+		}
+
+		group = item
+	}
+
+	if group == nil {
+		response.Diagnostics.AddError("Group not found", "No group matches the given criteria.")
+
+		return
+	}
+
+	members, diagn := getGroupMembers(ctx, group.Id, g.client)
+	response.Diagnostics.Append(diagn...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(group.Id)
+	data.Name = types.StringValue(group.Name)
+	data.ExternalId = types.StringPointerValue(group.ExternalId)
+	data.DisplayName = types.StringPointerValue(group.DisplayName)
+	data.Description = types.StringValue(group.Description)
+	data.MemberCount = types.Int64Value(int64(len(members)))
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (g *GroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.RaitoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.RaitoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *sdk.RaitoClient, not to be nil.",
+		)
+
+		return
+	}
+
+	g.client = client
+}
@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccGroupDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: false,
+		PreCheck: func() {
+			AccProviderPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_0_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "raito_identitystore" "snowflake" {
+	name = "Snowflake"
+}
+
+data "raito_group" "test" {
+	name = "SALES"
+	identity_store = data.raito_identitystore.snowflake.id
+}
+
+data "raito_group" "by_external_id" {
+	external_id = data.raito_group.test.external_id
+}
+
+data "raito_group" "by_external_id_in_identity_store" {
+	external_id = data.raito_group.test.external_id
+	identity_store = data.raito_identitystore.snowflake.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.raito_group.test", "name", "SALES"),
+					resource.TestCheckResourceAttrWith("data.raito_group.test", "id", func(value string) error {
+						if value == "" {
+							return errors.New("ID is not set")
+						}
+
+						return nil
+					}),
+					resource.TestCheckResourceAttrPair("data.raito_group.by_external_id", "id", "data.raito_group.test", "id"),
+					resource.TestCheckResourceAttrPair("data.raito_group.by_external_id", "member_count", "data.raito_group.test", "member_count"),
+					resource.TestCheckResourceAttrPair("data.raito_group.by_external_id_in_identity_store", "id", "data.raito_group.test", "id"),
+				),
+			},
+		},
+	})
+}
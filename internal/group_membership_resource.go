@@ -0,0 +1,354 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/raito-io/sdk-go"
+	raitoType "github.com/raito-io/sdk-go/types"
+)
+
+var _ resource.Resource = (*GroupMembershipResource)(nil)
+var _ resource.ResourceWithImportState = (*GroupMembershipResource)(nil)
+
+type GroupMembershipModel struct {
+	Id            types.String   `tfsdk:"id"`
+	Group         types.String   `tfsdk:"group"`
+	User          types.String   `tfsdk:"user"`
+	ChildGroup    types.String   `tfsdk:"child_group"`
+	IdentityStore types.String   `tfsdk:"identity_store"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GetMemberId returns the ID of the user or child group that is member of the group.
+func (m *GroupMembershipModel) GetMemberId() string {
+	if !m.User.IsNull() {
+		return m.User.ValueString()
+	}
+
+	return m.ChildGroup.ValueString()
+}
+
+func _generateMembershipId(identityStore, group, member string) string {
+	return identityStore + _separator + group + _separator + member
+}
+
+func _getMembershipFromId(id string) (identityStore, group, member string, err error) {
+	parts := strings.SplitN(id, _separator, 3)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid group membership id %q: expected format <identity_store>%s<group>%s<member>", id, _separator, _separator)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+type GroupMembershipResource struct {
+	client *sdk.RaitoClient
+}
+
+func NewGroupMembershipResource() resource.Resource {
+	return &GroupMembershipResource{}
+}
+
+func (g *GroupMembershipResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_group_membership"
+}
+
+func (g *GroupMembershipResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "Generated ID of the group membership",
+				MarkdownDescription: "Generated ID of the group membership",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The ID of the group",
+				MarkdownDescription: "The ID of the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The ID of the user that is added to the group",
+				MarkdownDescription: "The ID of the user that is added to the group. Exactly one of `user` or `child_group` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("child_group")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"child_group": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The ID of the group that is added to the group",
+				MarkdownDescription: "The ID of the group that is added to the group. Exactly one of `user` or `child_group` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identity_store": schema.StringAttribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The ID of the identity store in which the group is defined",
+				MarkdownDescription: "The ID of the identity store in which the group is defined",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+		Description:         "Group membership",
+		MarkdownDescription: "Non-authoritative membership of a single user or group in a Raito group. Other members of the group are left untouched. Do not combine a group membership with the `members` attribute of the `raito_group` resource of the same group, as that attribute is authoritative.",
+		Version:             1,
+	}
+}
+
+func (g *GroupMembershipResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data GroupMembershipModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diagnostics := data.Timeouts.Create(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "create", createTimeout, &response.Diagnostics)
+	defer done()
+
+	group, err := g.client.Group().GetGroup(ctx, data.Group.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Failed to read group", err.Error())
+
+		return
+	}
+
+	if !groupInIdentityStore(group, data.IdentityStore.ValueString()) {
+		response.Diagnostics.AddAttributeError(path.Root("identity_store"), "Group not in identity store", fmt.Sprintf("Group %q is not defined in identity store %q.", group.Id, data.IdentityStore.ValueString()))
+
+		return
+	}
+
+	err = g.client.Group().AddMembersToGroup(ctx, group.Id, []string{data.GetMemberId()})
+	if err != nil {
+		response.Diagnostics.AddError("Failed to add member to group", err.Error())
+
+		return
+	}
+
+	data.Id = types.StringValue(_generateMembershipId(data.IdentityStore.ValueString(), data.Group.ValueString(), data.GetMemberId()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (g *GroupMembershipResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var stateData GroupMembershipModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diagnostics := stateData.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
+	identityStoreId, groupId, memberId, err := _getMembershipFromId(stateData.Id.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Failed to parse group membership id", err.Error())
+
+		return
+	}
+
+	group, err := g.client.Group().GetGroup(ctx, groupId)
+	if err != nil {
+		var notFoundErr *raitoType.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			response.State.RemoveResource(ctx)
+		} else {
+			response.Diagnostics.AddError("Failed to read group", err.Error())
+		}
+
+		return
+	}
+
+	// The group was moved to another identity store outside of terraform
+	if !groupInIdentityStore(group, identityStoreId) {
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	memberList := g.client.Group().ListGroupMembers(cancelCtx, groupId)
+
+	for member := range memberList {
+		if member.HasError() {
+			response.Diagnostics.AddError("Failed to list group members", member.GetError().Error())
+
+			return
+		}
+
+		switch memberItem := member.GetItem().(type) {
+		case *raitoType.GroupMemberUser:
+			if memberItem.Id != memberId {
+				continue
+			}
+
+			stateData.User = types.StringValue(memberItem.Id)
+			stateData.ChildGroup = types.StringNull()
+		case *raitoType.GroupMemberGroup:
+			if memberItem.Id != memberId {
+				continue
+			}
+
+			stateData.User = types.StringNull()
+			stateData.ChildGroup = types.StringValue(memberItem.Id)
+		default:
+			continue
+		}
+
+		stateData.Group = types.StringValue(groupId)
+		stateData.IdentityStore = types.StringValue(identityStoreId)
+
+		response.Diagnostics.Append(response.State.Set(ctx, stateData)...)
+
+		return
+	}
+
+	// Membership edge was removed outside of terraform
+	response.State.RemoveResource(ctx)
+}
+
+func (g *GroupMembershipResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var planData, stateData GroupMembershipModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// All attributes require a replacement, so only the timeouts can be updated in place.
+	if !planData.Group.Equal(stateData.Group) || !planData.User.Equal(stateData.User) || !planData.ChildGroup.Equal(stateData.ChildGroup) || !planData.IdentityStore.Equal(stateData.IdentityStore) {
+		response.Diagnostics.AddError("Not able to update group membership", "Not able to update group membership")
+
+		return
+	}
+
+	stateData.Timeouts = planData.Timeouts
+
+	response.Diagnostics.Append(response.State.Set(ctx, stateData)...)
+}
+
+func (g *GroupMembershipResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data GroupMembershipModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diagnostics := data.Timeouts.Delete(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "delete", deleteTimeout, &response.Diagnostics)
+	defer done()
+
+	err := g.client.Group().RemoveMembersFromGroup(ctx, data.Group.ValueString(), []string{data.GetMemberId()})
+	if err != nil {
+		var notFoundErr *raitoType.ErrNotFound
+		if !errors.As(err, &notFoundErr) {
+			response.Diagnostics.AddError("Failed to remove member from group", err.Error())
+
+			return
+		}
+	}
+
+	response.State.RemoveResource(ctx)
+}
+
+func (g *GroupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*RaitoResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *internal.RaitoResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if providerData == nil || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *sdk.RaitoClient, not to be nil.",
+		)
+
+		return
+	}
+
+	g.client = providerData.Client
+}
+
+func (g *GroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
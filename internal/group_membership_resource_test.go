@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+)
+
+func TestGroupMembershipId(t *testing.T) {
+	id := _generateMembershipId("is1", "group1", "user1")
+
+	identityStore, group, member, err := _getMembershipFromId(id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if identityStore != "is1" || group != "group1" || member != "user1" {
+		t.Errorf("expected (is1, group1, user1), got (%s, %s, %s)", identityStore, group, member)
+	}
+
+	if _, _, _, err = _getMembershipFromId("group1#user1"); err == nil {
+		t.Errorf("expected error for id without identity store")
+	}
+}
+
+func TestAccGroupMembershipResource(t *testing.T) {
+	testId := gonanoid.Must(8)
+
+	t.Run("basic", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest: false,
+			PreCheck: func() {
+				AccProviderPreCheck(t)
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_0_0),
+			},
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + fmt.Sprintf(`
+data "raito_identitystore" "raito" {
+	name = "Raito"
+}
+
+resource "raito_user" "u1" {
+	name = "gm-tfTestUser-%[1]s"
+	email = "gm-test-user-%[1]s@raito.io"
+	raito_user = false
+}
+
+resource "raito_group" "parent" {
+	name = "tfTestGroupParent-%[1]s"
+	identity_store = data.raito_identitystore.raito.id
+}
+
+resource "raito_group" "child" {
+	name = "tfTestGroupChild-%[1]s"
+	identity_store = data.raito_identitystore.raito.id
+}
+
+resource "raito_group_membership" "user" {
+	group = raito_group.parent.id
+	user = raito_user.u1.id
+	identity_store = data.raito_identitystore.raito.id
+}
+
+resource "raito_group_membership" "child" {
+	group = raito_group.parent.id
+	child_group = raito_group.child.id
+	identity_store = data.raito_identitystore.raito.id
+}
+`, testId),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPair("raito_group_membership.user", "group", "raito_group.parent", "id"),
+						resource.TestCheckResourceAttrPair("raito_group_membership.user", "user", "raito_user.u1", "id"),
+						resource.TestCheckNoResourceAttr("raito_group_membership.user", "child_group"),
+						resource.TestCheckResourceAttrPair("raito_group_membership.child", "child_group", "raito_group.child", "id"),
+						resource.TestCheckNoResourceAttr("raito_group_membership.child", "user"),
+					),
+				},
+				{
+					ResourceName:      "raito_group_membership.user",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName:      "raito_group_membership.child",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
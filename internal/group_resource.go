@@ -0,0 +1,388 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/raito-io/golang-set/set"
+	"github.com/raito-io/sdk-go"
+	raitoType "github.com/raito-io/sdk-go/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/utils"
+)
+
+var _ resource.Resource = (*GroupResource)(nil)
+var _ resource.ResourceWithImportState = (*GroupResource)(nil)
+
+type GroupResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	DisplayName   types.String   `tfsdk:"display_name"`
+	Description   types.String   `tfsdk:"description"`
+	IdentityStore types.String   `tfsdk:"identity_store"`
+	Members       types.Set      `tfsdk:"members"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *GroupResourceModel) ToGroupInput() raitoType.GroupInput {
+	return raitoType.GroupInput{
+		Name:          m.Name.ValueStringPointer(),
+		DisplayName:   m.DisplayName.ValueStringPointer(),
+		Description:   m.Description.ValueStringPointer(),
+		IdentityStore: m.IdentityStore.ValueStringPointer(),
+	}
+}
+
+type GroupResource struct {
+	client *sdk.RaitoClient
+}
+
+func NewGroupResource() resource.Resource {
+	return &GroupResource{}
+}
+
+func (g *GroupResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_group"
+}
+
+func (g *GroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "The ID of the group",
+				MarkdownDescription: "The ID of the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The name of the group",
+				MarkdownDescription: "The name of the group",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"display_name": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The display name of the group",
+				MarkdownDescription: "The display name of the group",
+			},
+			"description": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The description of the group",
+				MarkdownDescription: "The description of the group",
+			},
+			"identity_store": schema.StringAttribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The ID of the native identity store in which the group is created",
+				MarkdownDescription: "The ID of the native identity store in which the group is created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The IDs of the users and groups that are member of the group. Members are managed authoritatively: members that are not listed are removed. If not set, members are not managed by this resource.",
+				MarkdownDescription: "The IDs of the users and groups that are member of the group. Members are managed authoritatively: members that are not listed are removed. If not set, members are not managed by this resource.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+		Description:         "Group resource",
+		MarkdownDescription: "The resource for representing a Raito native [Group](https://docs.raito.io/docs/cloud/identities).",
+		Version:             1,
+	}
+}
+
+func (g *GroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data GroupResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diagnostics := data.Timeouts.Create(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "create", createTimeout, &response.Diagnostics)
+	defer done()
+
+	group, err := g.client.Group().CreateGroup(ctx, data.ToGroupInput())
+	if err != nil {
+		response.Diagnostics.AddError("Failed to create group", err.Error())
+
+		return
+	}
+
+	data.Id = types.StringValue(group.Id)
+	response.Diagnostics.Append(response.State.Set(ctx, data)...) //Ensure to store id first
+
+	if response.Diagnostics.HasError() || data.Members.IsNull() {
+		return
+	}
+
+	response.Diagnostics.Append(g.setMembers(ctx, group.Id, data.Members)...)
+}
+
+func (g *GroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var stateData GroupResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diagnostics := stateData.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
+	group, err := g.client.Group().GetGroup(ctx, stateData.Id.ValueString())
+	if err != nil {
+		var notFoundErr *raitoType.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			response.State.RemoveResource(ctx)
+		} else {
+			response.Diagnostics.AddError("Failed to read group", err.Error())
+		}
+
+		return
+	}
+
+	actualData := GroupResourceModel{
+		Id:            types.StringValue(group.Id),
+		Name:          types.StringValue(group.Name),
+		DisplayName:   types.StringPointerValue(group.DisplayName),
+		Description:   stateData.Description,
+		IdentityStore: stateData.IdentityStore,
+		Members:       types.SetNull(types.StringType),
+		Timeouts:      stateData.Timeouts,
+	}
+
+	if group.Description != "" || !stateData.Description.IsNull() {
+		actualData.Description = types.StringValue(group.Description)
+	}
+
+	if actualData.IdentityStore.IsNull() && len(group.IdentityStores) > 0 {
+		// Import
+		actualData.IdentityStore = types.StringValue(group.IdentityStores[0].Id)
+	}
+
+	// Members are only read back if they are managed by this resource.
+	if !stateData.Members.IsNull() {
+		members, diagn := getGroupMembers(ctx, group.Id, g.client)
+		response.Diagnostics.Append(diagn...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		actualData.Members, diagn = utils.SliceToStringSet(ctx, members)
+		response.Diagnostics.Append(diagn...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &actualData)...)
+}
+
+func (g *GroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var planData GroupResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diagnostics := planData.Timeouts.Update(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "update", updateTimeout, &response.Diagnostics)
+	defer done()
+
+	_, err := g.client.Group().UpdateGroup(ctx, planData.Id.ValueString(), planData.ToGroupInput())
+	if err != nil {
+		response.Diagnostics.AddError("Failed to update group", err.Error())
+
+		return
+	}
+
+	if !planData.Members.IsNull() {
+		response.Diagnostics.Append(g.setMembers(ctx, planData.Id.ValueString(), planData.Members)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (g *GroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var stateData GroupResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diagnostics := stateData.Timeouts.Delete(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "delete", deleteTimeout, &response.Diagnostics)
+	defer done()
+
+	err := g.client.Group().DeleteGroup(ctx, stateData.Id.ValueString())
+	if err != nil {
+		var notFoundErr *raitoType.ErrNotFound
+		if !errors.As(err, &notFoundErr) {
+			response.Diagnostics.AddError("Failed to delete group "+stateData.Id.ValueString(), err.Error())
+
+			return
+		}
+	}
+
+	response.State.RemoveResource(ctx)
+}
+
+func (g *GroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*RaitoResourceData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *internal.RaitoResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if providerData == nil || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *sdk.RaitoClient, not to be nil.",
+		)
+
+		return
+	}
+
+	g.client = providerData.Client
+}
+
+func (g *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setMembers ensures the members of the group are exactly the given members.
+func (g *GroupResource) setMembers(ctx context.Context, groupId string, members types.Set) (diagnostics diag.Diagnostics) {
+	expectedMembers, diagn := utils.StringSetToSlice(ctx, members)
+	diagnostics.Append(diagn...)
+
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	currentMembers, diagn := getGroupMembers(ctx, groupId, g.client)
+	diagnostics.Append(diagn...)
+
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	expectedMemberSet := set.NewSet(expectedMembers...)
+	currentMemberSet := set.NewSet(currentMembers...)
+
+	var toAdd, toRemove []string
+
+	for member := range expectedMemberSet {
+		if !currentMemberSet.Contains(member) {
+			toAdd = append(toAdd, member)
+		}
+	}
+
+	for member := range currentMemberSet {
+		if !expectedMemberSet.Contains(member) {
+			toRemove = append(toRemove, member)
+		}
+	}
+
+	if len(toAdd) > 0 {
+		err := g.client.Group().AddMembersToGroup(ctx, groupId, toAdd)
+		if err != nil {
+			diagnostics.AddError("Failed to add members to group", err.Error())
+
+			return diagnostics
+		}
+	}
+
+	if len(toRemove) > 0 {
+		err := g.client.Group().RemoveMembersFromGroup(ctx, groupId, toRemove)
+		if err != nil {
+			diagnostics.AddError("Failed to remove members from group", err.Error())
+
+			return diagnostics
+		}
+	}
+
+	return diagnostics
+}
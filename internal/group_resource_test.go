@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+)
+
+func TestAccGroupResource(t *testing.T) {
+	testId := gonanoid.Must(8)
+
+	t.Run("basic", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest: false,
+			PreCheck: func() {
+				AccProviderPreCheck(t)
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_0_0),
+			},
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + fmt.Sprintf(`
+data "raito_identitystore" "raito" {
+	name = "Raito"
+}
+
+resource "raito_user" "u1" {
+	name = "group-tfTestUser-%[1]s"
+	email = "group-test-user-%[1]s@raito.io"
+	raito_user = false
+}
+
+resource "raito_group" "test" {
+	name = "tfTestGroup-%[1]s"
+	display_name = "Terraform test group"
+	description = "terraform test group"
+	identity_store = data.raito_identitystore.raito.id
+	members = [raito_user.u1.id]
+}
+
+data "raito_group" "test" {
+	name = raito_group.test.name
+	identity_store = raito_group.test.identity_store
+}
+`, testId),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("raito_group.test", "name", "tfTestGroup-"+testId),
+						resource.TestCheckResourceAttr("raito_group.test", "display_name", "Terraform test group"),
+						resource.TestCheckResourceAttr("raito_group.test", "description", "terraform test group"),
+						resource.TestCheckResourceAttr("raito_group.test", "members.#", "1"),
+						resource.TestCheckTypeSetElemAttrPair("raito_group.test", "members.*", "raito_user.u1", "id"),
+						resource.TestCheckResourceAttrPair("data.raito_group.test", "id", "raito_group.test", "id"),
+						resource.TestCheckResourceAttr("data.raito_group.test", "display_name", "Terraform test group"),
+						resource.TestCheckResourceAttr("data.raito_group.test", "member_count", "1"),
+					),
+				},
+				{
+					ResourceName:            "raito_group.test",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"members"},
+				},
+				{
+					Config: providerConfig + fmt.Sprintf(`
+data "raito_identitystore" "raito" {
+	name = "Raito"
+}
+
+resource "raito_user" "u1" {
+	name = "group-tfTestUser-%[1]s"
+	email = "group-test-user-%[1]s@raito.io"
+	raito_user = false
+}
+
+resource "raito_group" "test" {
+	name = "tfTestGroup-%[1]s"
+	display_name = "Terraform test group renamed"
+	identity_store = data.raito_identitystore.raito.id
+	members = []
+}
+`, testId),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("raito_group.test", "display_name", "Terraform test group renamed"),
+						resource.TestCheckNoResourceAttr("raito_group.test", "description"),
+						resource.TestCheckResourceAttr("raito_group.test", "members.#", "0"),
+					),
+				},
+			},
+		})
+	})
+}
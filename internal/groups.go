@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/raito-io/sdk-go"
+	raitoType "github.com/raito-io/sdk-go/types"
+)
+
+// getGroupMembers returns the IDs of the direct members (users and groups) of the given group.
+func getGroupMembers(ctx context.Context, groupId string, client *sdk.RaitoClient) (members []string, diagnostics diag.Diagnostics) {
+	cancelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	memberList := client.Group().ListGroupMembers(cancelCtx, groupId)
+
+	for member := range memberList {
+		if member.HasError() {
+			diagnostics.AddError("Failed to list group members", member.GetError().Error())
+
+			return nil, diagnostics
+		}
+
+		switch memberItem := member.GetItem().(type) {
+		case *raitoType.GroupMemberUser:
+			members = append(members, memberItem.Id)
+		case *raitoType.GroupMemberGroup:
+			members = append(members, memberItem.Id)
+		default:
+			diagnostics.AddError("Unexpected group member type", fmt.Sprintf("Expected *types.GroupMemberUser or *types.GroupMemberGroup, got: %T. Please report this issue to the provider developers.", memberItem))
+
+			return nil, diagnostics
+		}
+	}
+
+	return members, diagnostics
+}
+
+// groupInIdentityStore returns true if the group is linked to the given identity store.
+func groupInIdentityStore(group *raitoType.Group, identityStoreId string) bool {
+	for _, is := range group.IdentityStores {
+		if is.Id == identityStoreId {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -17,12 +18,13 @@ import (
 var _ datasource.DataSource = (*IdentityStoreDataSource)(nil)
 
 type IdentityStoreDataSourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Master      types.Bool   `tfsdk:"master"`
-	IsNative    types.Bool   `tfsdk:"is_native"`
-	Owners      types.Set    `tfsdk:"owners"`
+	Id          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Master      types.Bool     `tfsdk:"master"`
+	IsNative    types.Bool     `tfsdk:"is_native"`
+	Owners      types.Set      `tfsdk:"owners"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 type IdentityStoreDataSource struct {
@@ -91,6 +93,9 @@ func (i *IdentityStoreDataSource) Schema(ctx context.Context, request datasource
 				MarkdownDescription: "The IDs of the owners of the identity store",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": dataSourceTimeoutsBlock(ctx),
+		},
 		Description:         "Find a identity store by name",
 		MarkdownDescription: "Find a Raito [Identity Store](https://docs.raito.io/docs/cloud/identity_stores) by name.",
 	}
@@ -105,6 +110,16 @@ func (i *IdentityStoreDataSource) Read(ctx context.Context, request datasource.R
 		return
 	}
 
+	readTimeout, diagnostics := data.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
 	name := data.Name.ValueString()
 
 	cancelCtx, cancelFunc := context.WithCancel(ctx)
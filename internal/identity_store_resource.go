@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,8 +28,9 @@ type IdentityStoreResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	//Locked      types.Bool   `tfsdk:"locked"` // TODO
-	Master types.Bool `tfsdk:"master"`
-	Owners types.Set  `tfsdk:"owners"`
+	Master   types.Bool     `tfsdk:"master"`
+	Owners   types.Set      `tfsdk:"owners"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *IdentityStoreResourceModel) ToIdentityStoreInput() raitoType.IdentityStoreInput {
@@ -50,7 +52,7 @@ func (i *IdentityStoreResource) Metadata(_ context.Context, request resource.Met
 	response.TypeName = request.ProviderTypeName + "_identitystore"
 }
 
-func (i *IdentityStoreResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (i *IdentityStoreResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -110,6 +112,9 @@ func (i *IdentityStoreResource) Schema(_ context.Context, _ resource.SchemaReque
 				MarkdownDescription: "The IDs of the owners of the identity store",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 		Description:         "The identity store resource",
 		MarkdownDescription: "The resource for representing a Raito [Identity Store](https://docs.raito.io/docs/cloud/identity_stores).",
 		Version:             1,
@@ -125,6 +130,16 @@ func (i *IdentityStoreResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
+	createTimeout, diagnostics := data.Timeouts.Create(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "create", createTimeout, &response.Diagnostics)
+	defer done()
+
 	// Create identity store
 	isResult, err := i.client.IdentityStore().CreateIdentityStore(ctx, data.ToIdentityStoreInput())
 	if err != nil {
@@ -173,6 +188,16 @@ func (i *IdentityStoreResource) Read(ctx context.Context, request resource.ReadR
 		return
 	}
 
+	readTimeout, diagnostics := stateData.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
 	is, err := i.client.IdentityStore().GetIdentityStore(ctx, stateData.Id.ValueString())
 	if err != nil {
 		notFoundErr := &raitoType.ErrNotFound{}
@@ -190,6 +215,7 @@ func (i *IdentityStoreResource) Read(ctx context.Context, request resource.ReadR
 		Name:        types.StringValue(is.Name),
 		Description: types.StringValue(is.Description),
 		Master:      types.BoolValue(is.Master),
+		Timeouts:    stateData.Timeouts,
 	}
 
 	owners, diagn := getOwners(ctx, stateData.Id.ValueString(), i.client)
@@ -212,6 +238,16 @@ func (i *IdentityStoreResource) Update(ctx context.Context, request resource.Upd
 		return
 	}
 
+	updateTimeout, diagnostics := data.Timeouts.Update(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "update", updateTimeout, &response.Diagnostics)
+	defer done()
+
 	_, err := i.client.IdentityStore().UpdateIdentityStore(ctx, data.Id.ValueString(), data.ToIdentityStoreInput())
 	if err != nil {
 		response.Diagnostics.AddError("Failed to update identity store", err.Error())
@@ -258,6 +294,16 @@ func (i *IdentityStoreResource) Delete(ctx context.Context, request resource.Del
 		return
 	}
 
+	deleteTimeout, diagnostics := data.Timeouts.Delete(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "delete", deleteTimeout, &response.Diagnostics)
+	defer done()
+
 	currentUser, err := i.client.User().GetCurrentUser(ctx)
 	if err != nil {
 		response.Diagnostics.AddError("Failed to get current user", err.Error())
@@ -302,18 +348,18 @@ func (i *IdentityStoreResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*sdk.RaitoClient)
+	providerData, ok := req.ProviderData.(*RaitoResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.RaitoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *internal.RaitoResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	if client == nil {
+	if providerData == nil || providerData.Client == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
 			"Expected *sdk.RaitoClient, not to be nil.",
@@ -322,7 +368,7 @@ func (i *IdentityStoreResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	i.client = client
+	i.client = providerData.Client
 }
 
 func (i *IdentityStoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"strings"
+
+	raitoType "github.com/raito-io/sdk-go/types"
+)
+
+const (
+	// lockMsg is the lock reason used if no lock_reason is configured on the provider or the resource.
+	lockMsg = "Locked by terraform"
+
+	nameLock   = "name"
+	deleteLock = "delete"
+
+	workspaceEnvVar      = "TF_WORKSPACE"
+	cloudWorkspaceEnvVar = "TFC_WORKSPACE_NAME"
+	defaultWorkspace     = "default"
+)
+
+// selectableLocks maps the values of the locks attribute to the locks in Raito Cloud.
+var selectableLocks = map[string]raitoType.AccessProviderLock{
+	nameLock:   raitoType.AccessProviderLockNamelock,
+	deleteLock: raitoType.AccessProviderLockDeletelock,
+}
+
+// lockReasonPlaceholdersDescription documents the supported placeholders in a lock reason template.
+const lockReasonPlaceholdersDescription = "The following placeholders are replaced: `{workspace}` (the Terraform workspace, taken from the `TF_WORKSPACE` or `TFC_WORKSPACE_NAME` environment variable), `{resource_type}` (e.g. `raito_grant`) and `{name}` (the name of the access control). Terraform does not pass module or resource addresses to providers, so no placeholders exist for them. Use Terraform expressions like `path.module` in the `lock_reason` of an access control instead."
+
+// renderLockReason replaces the placeholders in the given lock reason template.
+func renderLockReason(template string, resourceType string, name string, getenv func(string) string) string {
+	return strings.NewReplacer(
+		"{workspace}", terraformWorkspace(getenv),
+		"{resource_type}", resourceType,
+		"{name}", name,
+	).Replace(template)
+}
+
+// terraformWorkspace returns the name of the current Terraform workspace.
+// Terraform does not pass the workspace to providers, so it is taken from the environment.
+func terraformWorkspace(getenv func(string) string) string {
+	for _, envVar := range []string{workspaceEnvVar, cloudWorkspaceEnvVar} {
+		if workspace := getenv(envVar); workspace != "" {
+			return workspace
+		}
+	}
+
+	return defaultWorkspace
+}
+
+// setLockReason sets the given reason on all locks of the access provider input.
+func setLockReason(input *raitoType.AccessProviderInput, reason string) {
+	for i := range input.Locks {
+		if input.Locks[i].Details == nil {
+			input.Locks[i].Details = &raitoType.AccessProviderLockDetailsInput{}
+		}
+
+		input.Locks[i].Details.Reason = &reason
+	}
+}
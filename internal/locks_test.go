@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"testing"
+)
+
+func TestRenderLockReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		env      map[string]string
+		want     string
+	}{
+		{
+			name:     "without placeholders",
+			template: lockMsg,
+			want:     "Locked by terraform",
+		},
+		{
+			name:     "all placeholders",
+			template: "Managed by {resource_type} {name} in workspace {workspace}",
+			env:      map[string]string{workspaceEnvVar: "prod"},
+			want:     "Managed by raito_grant tfGrant in workspace prod",
+		},
+		{
+			name:     "terraform cloud workspace",
+			template: "{workspace}",
+			env:      map[string]string{cloudWorkspaceEnvVar: "cloud-workspace"},
+			want:     "cloud-workspace",
+		},
+		{
+			name:     "default workspace",
+			template: "{workspace}/{workspace}",
+			want:     "default/default",
+		},
+		{
+			name:     "unknown placeholders are kept",
+			template: "{module} {name}",
+			want:     "{module} tfGrant",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := renderLockReason(tt.template, "raito_grant", "tfGrant", func(key string) string {
+				return tt.env[key]
+			})
+
+			if got != tt.want {
+				t.Errorf("renderLockReason(%q) = %q, want %q", tt.template, got, tt.want)
+			}
+		})
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"slices"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -25,15 +25,20 @@ var _ resource.Resource = (*MaskResource)(nil)
 
 type MaskResourceModel struct {
 	// AccessProviderResourceModel properties. This has to be duplicated because of https://github.com/hashicorp/terraform-plugin-framework/issues/242
-	Id                types.String         `tfsdk:"id"`
-	Name              types.String         `tfsdk:"name"`
-	Description       types.String         `tfsdk:"description"`
-	State             types.String         `tfsdk:"state"`
-	Who               types.Set            `tfsdk:"who"`
-	Owners            types.Set            `tfsdk:"owners"`
-	WhoAbacRule       jsontypes.Normalized `tfsdk:"who_abac_rule"`
-	WhoLocked         types.Bool           `tfsdk:"who_locked"`
-	InheritanceLocked types.Bool           `tfsdk:"inheritance_locked"`
+	Id                types.String                        `tfsdk:"id"`
+	Name              types.String                        `tfsdk:"name"`
+	Description       types.String                        `tfsdk:"description"`
+	State             types.String                        `tfsdk:"state"`
+	Who               types.Set                           `tfsdk:"who"`
+	Owners            types.Set                           `tfsdk:"owners"`
+	WhoAbacRule       abac_expression.AbacRuleValue       `tfsdk:"who_abac_rule"`
+	WhoAbac           types.Object                        `tfsdk:"who_abac"`
+	WhoAbacExpression abac_expression.AbacExpressionValue `tfsdk:"who_abac_expression"`
+	WhoLocked         types.Bool                          `tfsdk:"who_locked"`
+	InheritanceLocked types.Bool                          `tfsdk:"inheritance_locked"`
+	Locks             types.Set                           `tfsdk:"locks"`
+	LockReason        types.String                        `tfsdk:"lock_reason"`
+	Timeouts          timeouts.Value                      `tfsdk:"timeouts"`
 
 	// MaskResourceModel properties.
 	Type         types.String `tfsdk:"type"`
@@ -52,8 +57,13 @@ func (m *MaskResourceModel) GetAccessProviderResourceModel() *AccessProviderReso
 		Who:               m.Who,
 		Owners:            m.Owners,
 		WhoAbacRule:       m.WhoAbacRule,
+		WhoAbac:           m.WhoAbac,
+		WhoAbacExpression: m.WhoAbacExpression,
 		WhoLocked:         m.WhoLocked,
 		InheritanceLocked: m.InheritanceLocked,
+		Locks:             m.Locks,
+		LockReason:        m.LockReason,
+		Timeouts:          m.Timeouts,
 	}
 }
 
@@ -65,8 +75,13 @@ func (m *MaskResourceModel) SetAccessProviderResourceModel(ap *AccessProviderRes
 	m.Who = ap.Who
 	m.Owners = ap.Owners
 	m.WhoAbacRule = ap.WhoAbacRule
+	m.WhoAbac = ap.WhoAbac
+	m.WhoAbacExpression = ap.WhoAbacExpression
 	m.WhoLocked = ap.WhoLocked
 	m.InheritanceLocked = ap.InheritanceLocked
+	m.Locks = ap.Locks
+	m.LockReason = ap.LockReason
+	m.Timeouts = ap.Timeouts
 }
 
 func (m *MaskResourceModel) ToAccessProviderInput(ctx context.Context, client *sdk.RaitoClient, result *raitoType.AccessProviderInput) diag.Diagnostics {
@@ -212,16 +227,14 @@ func (m *MaskResourceModel) abacWhatToAccessProviderInput(ctx context.Context, c
 		}
 	}
 
-	jsonRule := attributes["rule"].(jsontypes.Normalized)
+	abacRule, abacDiagnostics := whatAbacRuleExpression(ctx, attributes)
+	diagnostics.Append(abacDiagnostics...)
 
-	var abacRule abac_expression.BinaryExpression
-	diagnostics.Append(jsonRule.Unmarshal(&abacRule)...)
-
-	if diagnostics.HasError() {
+	if diagnostics.HasError() || abacRule == nil {
 		return diagnostics
 	}
 
-	abacInput, err := abacRule.ToGqlInput()
+	abacInput, err := abacRuleToGqlInput(abacRule)
 	if err != nil {
 		diagnostics.AddError("Failed to convert abac rule to gql input", err.Error())
 
@@ -240,11 +253,18 @@ func (m *MaskResourceModel) abacWhatToAccessProviderInput(ctx context.Context, c
 
 func (m *MaskResourceModel) abacWhatFromAccessProvider(ctx context.Context, client *sdk.RaitoClient, ap *raitoType.AccessProvider) (_ types.Object, diagnostics diag.Diagnostics) {
 	objectTypes := map[string]attr.Type{
-		"scope": types.SetType{ElemType: types.StringType},
-		"rule":  jsontypes.NormalizedType{},
+		"scope":           types.SetType{ElemType: types.StringType},
+		"rule":            abac_expression.AbacRuleType{},
+		"abac":            types.ObjectType{AttrTypes: abacRuleAttributeTypes},
+		"rule_expression": abac_expression.AbacExpressionType{},
 	}
 
-	abacRule := jsontypes.NewNormalizedPointerValue(ap.WhatAbacRule.RuleJson)
+	abacRule, abac, ruleExpression, abacDiagnostics := whatAbacRuleFromServer(ctx, m.WhatAbacRule, serverAbacRule{Rule: ap.WhatAbacRule.Rule, RuleJson: ap.WhatAbacRule.RuleJson})
+	diagnostics.Append(abacDiagnostics...)
+
+	if diagnostics.HasError() {
+		return types.ObjectNull(objectTypes), diagnostics
+	}
 
 	var scopeItems []attr.Value //nolint:prealloc
 
@@ -269,8 +289,10 @@ func (m *MaskResourceModel) abacWhatFromAccessProvider(ctx context.Context, clie
 	}
 
 	object, whatAbacDiagnostics := types.ObjectValue(objectTypes, map[string]attr.Value{
-		"rule":  abacRule,
-		"scope": scope,
+		"rule":            abacRule,
+		"abac":            abac,
+		"rule_expression": ruleExpression,
+		"scope":           scope,
 	})
 
 	diagnostics.Append(whatAbacDiagnostics...)
@@ -289,6 +311,7 @@ type MaskResource struct {
 func NewMaskResource() resource.Resource {
 	return &MaskResource{
 		AccessProviderResource: AccessProviderResource[MaskResourceModel, *MaskResourceModel]{
+			resourceType: "raito_mask",
 			readHooks: []ReadHook[MaskResourceModel, *MaskResourceModel]{
 				readMaskResourceColumns,
 			},
@@ -306,7 +329,7 @@ func (m *MaskResource) Metadata(_ context.Context, request resource.MetadataRequ
 	response.TypeName = request.ProviderTypeName + "_mask"
 }
 
-func (m *MaskResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (m *MaskResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	attributes := m.schema("mask")
 	attributes["type"] = schema.StringAttribute{
 		Required:            true,
@@ -349,13 +372,27 @@ func (m *MaskResource) Schema(_ context.Context, _ resource.SchemaRequest, respo
 				MarkdownDescription: "Scope of the defined abac rule",
 			},
 			"rule": schema.StringAttribute{
-				CustomType:          jsontypes.NormalizedType{},
-				Required:            true,
-				Optional:            false,
+				CustomType:          abac_expression.AbacRuleType{},
+				Required:            false,
+				Optional:            true,
 				Computed:            false,
 				Sensitive:           false,
-				Description:         "json representation of the abac rule",
-				MarkdownDescription: "json representation of the abac rule",
+				Description:         "json representation of the abac rule. Exactly one of rule, abac or rule_expression must be set.",
+				MarkdownDescription: "json representation of the abac rule. Exactly one of `rule`, `abac` or `rule_expression` must be set.",
+				Default:             nil,
+			},
+			"abac": abacRuleSchemaAttribute(
+				"Structured representation of the abac rule. Exactly one of rule, abac or rule_expression must be set.",
+				"Structured representation of the abac rule. The rule matches if all conditions of at least one `any_of` item match. Exactly one of `rule`, `abac` or `rule_expression` must be set.",
+			),
+			"rule_expression": schema.StringAttribute{
+				CustomType:          abac_expression.AbacExpressionType{},
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The abac rule, written in the abac expression language. Exactly one of rule, abac or rule_expression must be set.",
+				MarkdownDescription: "The abac rule, written in the abac expression language (e.g. `has_tag(\"department\", \"Finance\") and not contains_tag(\"pii\", \"x\")`). See the abac guide for the syntax. Exactly one of `rule`, `abac` or `rule_expression` must be set.",
 				Default:             nil,
 			},
 		},
@@ -376,7 +413,10 @@ func (m *MaskResource) Schema(_ context.Context, _ resource.SchemaRequest, respo
 	}
 
 	response.Schema = schema.Schema{
-		Attributes:          attributes,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 		Description:         "The mask access control resource",
 		MarkdownDescription: "The resource for representing a Raito [Column Mask](https://docs.raito.io/docs/cloud/access_management/masks) access control.",
 		Version:             1,
@@ -422,11 +462,13 @@ func readMaskResourceColumns(ctx context.Context, client *sdk.RaitoClient, data
 	return diagnostics
 }
 
-func validateMaskWhatLock(_ context.Context, data *MaskResourceModel) (diagnostics diag.Diagnostics) {
+func validateMaskWhatLock(ctx context.Context, data *MaskResourceModel) (diagnostics diag.Diagnostics) {
 	if (!data.Columns.IsNull() || !data.WhatAbacRule.IsNull()) && (!data.WhatLocked.IsNull() && !data.WhatLocked.ValueBool()) {
 		diagnostics.AddError("What lock should be true", "Columns or what abac rule should be set, so what lock should be true")
 	}
 
+	diagnostics.Append(validateWhatAbacRule(ctx, data.WhatAbacRule)...)
+
 	return diagnostics
 }
 
@@ -15,9 +15,35 @@ import (
 )
 
 func getOwners(ctx context.Context, id string, client *sdk.RaitoClient) (result types.Set, diagnostics diag.Diagnostics) {
-	ownersList := client.Role().ListRoleAssignments(ctx, services.WithRoleAssignmentListFilter(
+	users, groups, err := listRoleAssignees(ctx, id, ownerRole, client)
+	if err != nil {
+		diagnostics.AddError("Failed to list owners", err.Error())
+
+		return result, diagnostics
+	}
+
+	owners := make([]attr.Value, 0, len(users)+len(groups))
+
+	for _, user := range users {
+		owners = append(owners, types.StringValue(user))
+	}
+
+	for _, group := range groups {
+		owners = append(owners, types.StringValue(group))
+	}
+
+	return types.SetValue(types.StringType, owners)
+}
+
+// listRoleAssignees returns the IDs of the users and groups that have the given role directly assigned on the resource.
+// Inherited and delegated role assignments are ignored.
+func listRoleAssignees(ctx context.Context, id string, role string, client *sdk.RaitoClient) (users []string, groups []string, err error) {
+	cancelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	assigneeList := client.Role().ListRoleAssignments(cancelCtx, services.WithRoleAssignmentListFilter(
 		&raitoType.RoleAssignmentFilterInput{
-			Role:               utils.Ptr(ownerRole),
+			Role:               &role,
 			Resource:           &id,
 			ExcludeDelegated:   utils.Ptr(true),
 			ExcludeDelegations: utils.Ptr(true),
@@ -26,26 +52,20 @@ func getOwners(ctx context.Context, id string, client *sdk.RaitoClient) (result
 	),
 	)
 
-	var owners []attr.Value
-
-	for owner := range ownersList {
-		if owner.HasError() {
-			diagnostics.AddError("Failed to list owners", owner.GetError().Error())
-
-			return result, diagnostics
+	for assignee := range assigneeList {
+		if assignee.HasError() {
+			return nil, nil, assignee.GetError()
 		}
 
-		switch ownerItem := owner.GetItem().GetTo().(type) {
+		switch assigneeItem := assignee.GetItem().GetTo().(type) {
 		case *raitoType.RoleAssignmentToUser:
-			owners = append(owners, types.StringValue(ownerItem.Id))
+			users = append(users, assigneeItem.Id)
 		case *raitoType.RoleAssignmentToGroup:
-			owners = append(owners, types.StringValue(ownerItem.Id))
+			groups = append(groups, assigneeItem.Id)
 		default:
-			diagnostics.AddError("Unexpected owner type", fmt.Sprintf("Expected *types2.RoleAssignmentToUser or *types2.RoleAssignmentToGroup, got: %T. Please report this issue to the provider developers.", ownerItem))
-
-			return result, diagnostics
+			return nil, nil, fmt.Errorf("expected *types2.RoleAssignmentToUser or *types2.RoleAssignmentToGroup, got: %T. Please report this issue to the provider developers", assigneeItem)
 		}
 	}
 
-	return types.SetValue(types.StringType, owners)
+	return users, groups, nil
 }
@@ -2,18 +2,26 @@ package internal
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 
 	"github.com/raito-io/sdk-go"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure RaitoCloudProvider satisfies various provider interfaces.
 var _ provider.Provider = &RaitoCloudProvider{}
+var _ provider.ProviderWithValidateConfig = &RaitoCloudProvider{}
+var _ provider.ProviderWithFunctions = &RaitoCloudProvider{}
 
 // RaitoCloudProvider defines the provider implementation.
 type RaitoCloudProvider struct {
@@ -29,6 +37,18 @@ type RaitoCloudProviderModel struct {
 	User        types.String `tfsdk:"user"`
 	Secret      types.String `tfsdk:"secret"`
 	UrlOverride types.String `tfsdk:"url_override"`
+	Profile     types.String `tfsdk:"profile"`
+	LockReason  types.String `tfsdk:"lock_reason"`
+
+	Retry *RaitoCloudProviderRetryModel `tfsdk:"retry"`
+}
+
+// RaitoResourceData is passed to all resources of the provider.
+type RaitoResourceData struct {
+	Client *sdk.RaitoClient
+
+	// LockReason is the lock reason template used for all locks set by the provider.
+	LockReason string
 }
 
 func (p *RaitoCloudProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -40,36 +60,69 @@ func (p *RaitoCloudProvider) Schema(ctx context.Context, req provider.SchemaRequ
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"domain": schema.StringAttribute{
-				Required:            true,
-				Optional:            false,
+				Required:            false,
+				Optional:            true,
 				Sensitive:           false,
-				Description:         "The subdomain of your Raito Cloud instance (i.e. https://<this_part>.raito.cloud)",
-				MarkdownDescription: "The subdomain of your Raito Cloud instance (i.e. https://<this_part>.raito.cloud)",
+				Description:         "The subdomain of your Raito Cloud instance (i.e. https://<this_part>.raito.cloud). Can also be set with the RAITO_DOMAIN environment variable or in the credentials file.",
+				MarkdownDescription: "The subdomain of your Raito Cloud instance (i.e. https://<this_part>.raito.cloud). Can also be set with the `RAITO_DOMAIN` environment variable or in the credentials file.",
 			},
 			"user": schema.StringAttribute{
-				Required:            true,
-				Optional:            false,
+				Required:            false,
+				Optional:            true,
 				Sensitive:           false,
-				Description:         "The username to use to sign in to your Raito Cloud instance",
-				MarkdownDescription: "The username to use to sign in to your Raito Cloud instance",
+				Description:         "The username to use to sign in to your Raito Cloud instance. Can also be set with the RAITO_USER environment variable or in the credentials file.",
+				MarkdownDescription: "The username to use to sign in to your Raito Cloud instance. Can also be set with the `RAITO_USER` environment variable or in the credentials file.",
 			},
 			"secret": schema.StringAttribute{
-				Required:            true,
-				Optional:            false,
+				Required:            false,
+				Optional:            true,
 				Sensitive:           true,
-				Description:         "The password to use to sign in to your Raito Cloud instance",
-				MarkdownDescription: "The password to use to sign in to your Raito Cloud instance",
+				Description:         "The password to use to sign in to your Raito Cloud instance. Can also be set with the RAITO_SECRET environment variable or in the credentials file.",
+				MarkdownDescription: "The password to use to sign in to your Raito Cloud instance. Can also be set with the `RAITO_SECRET` environment variable or in the credentials file.",
 			},
 			"url_override": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
 				Sensitive:   false,
-				Description: "If set, this URL is used as address for the Raito Cloud API. Only used for testing purposes.",
+				Description: "If set, this URL is used as address for the Raito Cloud API. Only used for testing purposes. Can also be set in the credentials file.",
+			},
+			"profile": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Description:         "The profile in ~/.raito/credentials used to resolve the settings that are not set in the provider configuration or environment variables. Can also be set with the RAITO_PROFILE environment variable. Default: default",
+				MarkdownDescription: "The profile in `~/.raito/credentials` used to resolve the settings that are not set in the provider configuration or environment variables. Can also be set with the `RAITO_PROFILE` environment variable. Default: `default`",
+			},
+			"lock_reason": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Description:         fmt.Sprintf("The reason shown in Raito Cloud on all locks set by the provider. Can be overridden with the lock_reason attribute of each access control. Default: %s", lockMsg),
+				MarkdownDescription: fmt.Sprintf("The reason shown in Raito Cloud on all locks set by the provider. Can be overridden with the `lock_reason` attribute of each access control. %s Default: `%s`", lockReasonPlaceholdersDescription, lockMsg),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"retry": retrySchemaBlock(),
+		},
 	}
 }
 
+func (p *RaitoCloudProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data RaitoCloudProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diagnostics := data.Retry.ToRetryConfig(ctx)
+	resp.Diagnostics.Append(diagnostics...)
+}
+
 func (p *RaitoCloudProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data RaitoCloudProviderModel
 
@@ -79,16 +132,42 @@ func (p *RaitoCloudProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	credentials, diagnostics := newCredentialResolver().Resolve(&data)
+	resp.Diagnostics.Append(diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retryConfig, diagnostics := data.Retry.ToRetryConfig(ctx)
+	resp.Diagnostics.Append(diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if installedConfig := configureRetry(retryConfig); !reflect.DeepEqual(installedConfig, retryConfig) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("retry"), "Retry configuration ignored", "The retry configuration applies to the whole provider process and was already set by another provider configuration. Use the same retry block in all provider configurations.")
+	}
+
 	var options []func(options *sdk.ClientOptions)
 
-	if !data.UrlOverride.IsNull() {
-		options = append(options, sdk.WithUrlOverride(data.UrlOverride.ValueString()))
+	if credentials.UrlOverride != "" {
+		options = append(options, sdk.WithUrlOverride(credentials.UrlOverride))
 	}
 
-	client := sdk.NewClient(ctx, data.Domain.ValueString(), data.User.ValueString(), data.Secret.ValueString(), options...)
+	client := sdk.NewClient(ctx, credentials.Domain, credentials.User, credentials.Secret, options...)
+
+	lockReason := lockMsg
+	if !data.LockReason.IsNull() && !data.LockReason.IsUnknown() {
+		lockReason = data.LockReason.ValueString()
+	}
 
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.ResourceData = &RaitoResourceData{
+		Client:     client,
+		LockReason: lockReason,
+	}
 }
 
 func (p *RaitoCloudProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -96,23 +175,54 @@ func (p *RaitoCloudProvider) Resources(_ context.Context) []func() resource.Reso
 		NewDataSourceResource,
 		NewIdentityStoreResource,
 		NewGlobalRoleAssignmentResource,
+		NewGroupResource,
+		NewGroupMembershipResource,
 		NewGrantCategoryResource,
 		NewGrantResource,
 		NewFilterResource,
 		NewMaskResource,
+		NewPurposeResource,
+		NewRoleAssignmentResource,
 		NewUserResource,
 	}
 }
 
 func (p *RaitoCloudProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAccessProvidersDataSource,
 		NewDataSourceDataSource,
+		NewDataObjectDataSource,
+		NewDataObjectsDataSource,
+		NewFilterDataSource,
+		NewGrantDataSource,
 		NewGrantCategoryDataSource,
+		NewGroupDataSource,
 		NewIdentityStoreDataSource,
+		NewMaskDataSource,
+		NewPurposeDataSource,
 		NewUserDataSource,
 	}
 }
 
+func (p *RaitoCloudProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewAbacEvaluateFunction,
+		NewAbacHasTagFunction,
+		NewAbacContainsTagFunction,
+		NewAbacPropertyEqualsFunction,
+		NewAbacPropertyInFunction,
+		NewAbacAndFunction,
+		NewAbacOrFunction,
+		NewAbacNotFunction,
+		NewAbacRuleFunction,
+		NewAbacMatchesFunction,
+		NewFullnameJoinFunction,
+		NewFullnameSplitFunction,
+		NewFullnameParentFunction,
+		NewFullnameQuoteFunction,
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &RaitoCloudProvider{
@@ -0,0 +1,212 @@
+package internal
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	domainEnvVar  = "RAITO_DOMAIN"
+	userEnvVar    = "RAITO_USER"
+	secretEnvVar  = "RAITO_SECRET"
+	profileEnvVar = "RAITO_PROFILE"
+
+	defaultProfile      = "default"
+	credentialsFilePath = ".raito/credentials"
+)
+
+// providerCredentials are the resolved settings used to create the Raito client.
+type providerCredentials struct {
+	Domain      string
+	User        string
+	Secret      string
+	UrlOverride string
+}
+
+// credentialResolver resolves the provider credentials in the following order:
+// the provider configuration, environment variables and finally a profile in the credentials file.
+type credentialResolver struct {
+	getenv          func(string) string
+	credentialsFile string
+}
+
+func newCredentialResolver() *credentialResolver {
+	credentialsFile := ""
+
+	if home, err := os.UserHomeDir(); err == nil {
+		credentialsFile = filepath.Join(home, credentialsFilePath)
+	}
+
+	return &credentialResolver{
+		getenv:          os.Getenv,
+		credentialsFile: credentialsFile,
+	}
+}
+
+func (r *credentialResolver) Resolve(data *RaitoCloudProviderModel) (_ providerCredentials, diagnostics diag.Diagnostics) {
+	configValues := []struct {
+		attribute string
+		value     types.String
+	}{
+		{attribute: "domain", value: data.Domain},
+		{attribute: "user", value: data.User},
+		{attribute: "secret", value: data.Secret},
+		{attribute: "url_override", value: data.UrlOverride},
+		{attribute: "profile", value: data.Profile},
+	}
+
+	for _, configValue := range configValues {
+		if configValue.value.IsUnknown() {
+			diagnostics.AddAttributeError(path.Root(configValue.attribute), fmt.Sprintf("Unknown Raito %s", configValue.attribute), fmt.Sprintf("The provider cannot create the Raito client as there is an unknown configuration value for %q. Either apply the source of the value first, set the value statically in the configuration, or remove it to use the environment or credentials file.", configValue.attribute))
+		}
+	}
+
+	if diagnostics.HasError() {
+		return providerCredentials{}, diagnostics
+	}
+
+	profileName, profileExplicit := r.profileName(data)
+
+	profile, profileErr := r.loadProfile(profileName)
+	if profileErr != nil && profileExplicit {
+		diagnostics.AddAttributeError(path.Root("profile"), "Failed to load Raito profile", fmt.Sprintf("Profile %q could not be loaded from %s: %s", profileName, r.credentialsFileDescription(), profileErr.Error()))
+
+		return providerCredentials{}, diagnostics
+	}
+
+	profileSource := fmt.Sprintf("profile %q in %s", profileName, r.credentialsFileDescription())
+	if profileErr != nil {
+		profileSource = fmt.Sprintf("%s (%s)", profileSource, profileErr.Error())
+	}
+
+	resolve := func(attribute string, value types.String, envVar string, required bool) string {
+		if isConfigured(value) {
+			return value.ValueString()
+		}
+
+		triedSources := []string{fmt.Sprintf("provider attribute %q", attribute)}
+
+		if envVar != "" {
+			if envValue := r.getenv(envVar); envValue != "" {
+				return envValue
+			}
+
+			triedSources = append(triedSources, fmt.Sprintf("environment variable %s", envVar))
+		}
+
+		if profileValue, found := profile[attribute]; found && profileValue != "" {
+			return profileValue
+		}
+
+		triedSources = append(triedSources, profileSource)
+
+		if required {
+			diagnostics.AddAttributeError(path.Root(attribute), fmt.Sprintf("Missing Raito %s", attribute), fmt.Sprintf("The Raito %s could not be resolved. Tried %s.", attribute, strings.Join(triedSources, ", ")))
+		}
+
+		return ""
+	}
+
+	credentials := providerCredentials{
+		Domain:      resolve("domain", data.Domain, domainEnvVar, true),
+		User:        resolve("user", data.User, userEnvVar, true),
+		Secret:      resolve("secret", data.Secret, secretEnvVar, true),
+		UrlOverride: resolve("url_override", data.UrlOverride, "", false),
+	}
+
+	return credentials, diagnostics
+}
+
+func isConfigured(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
+
+// profileName returns the name of the profile to use and whether it was explicitly selected.
+func (r *credentialResolver) profileName(data *RaitoCloudProviderModel) (string, bool) {
+	if !data.Profile.IsNull() && data.Profile.ValueString() != "" {
+		return data.Profile.ValueString(), true
+	}
+
+	if profile := r.getenv(profileEnvVar); profile != "" {
+		return profile, true
+	}
+
+	return defaultProfile, false
+}
+
+func (r *credentialResolver) credentialsFileDescription() string {
+	if r.credentialsFile == "" {
+		return "~/" + credentialsFilePath
+	}
+
+	return r.credentialsFile
+}
+
+// loadProfile reads a single profile from the INI formatted credentials file.
+func (r *credentialResolver) loadProfile(profileName string) (map[string]string, error) {
+	if r.credentialsFile == "" {
+		return nil, errors.New("unable to determine home directory")
+	}
+
+	file, err := os.Open(r.credentialsFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, errors.New("file not found")
+		}
+
+		return nil, fmt.Errorf("open credentials file: %w", err)
+	}
+
+	defer file.Close()
+
+	values := map[string]string{}
+	found := false
+	inProfile := false
+	scanner := bufio.NewScanner(file)
+	lineNr := 0
+
+	for scanner.Scan() {
+		lineNr++
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			inProfile = strings.TrimSpace(line[1:len(line)-1]) == profileName
+			found = found || inProfile
+
+			continue
+		}
+
+		if !inProfile {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid line %d: expected key = value", lineNr)
+		}
+
+		values[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read credentials file: %w", err)
+	}
+
+	if !found {
+		return nil, errors.New("profile not found")
+	}
+
+	return values, nil
+}
@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCredentialResolver_Resolve(t *testing.T) {
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+
+	err := os.WriteFile(credentialsFile, []byte(`
+# Raito credentials
+[default]
+domain = default-domain
+user   = default@raito.io
+secret = "default-secret"
+
+[e2e]
+domain       = e2e
+user         = e2e@raito.io
+secret       = e2e-secret
+url_override = https://api.raito.dev
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emptyModel := RaitoCloudProviderModel{
+		Domain:      types.StringNull(),
+		User:        types.StringNull(),
+		Secret:      types.StringNull(),
+		UrlOverride: types.StringNull(),
+		Profile:     types.StringNull(),
+	}
+
+	tests := []struct {
+		name            string
+		model           func() RaitoCloudProviderModel
+		env             map[string]string
+		credentialsFile string
+		want            providerCredentials
+		wantErr         string
+	}{
+		{
+			name: "configuration takes precedence",
+			model: func() RaitoCloudProviderModel {
+				m := emptyModel
+				m.Domain = types.StringValue("config-domain")
+				m.User = types.StringValue("config@raito.io")
+				m.Secret = types.StringValue("config-secret")
+
+				return m
+			},
+			env:             map[string]string{userEnvVar: "env@raito.io"},
+			credentialsFile: credentialsFile,
+			want:            providerCredentials{Domain: "config-domain", User: "config@raito.io", Secret: "config-secret"},
+		},
+		{
+			name: "environment before default profile",
+			model: func() RaitoCloudProviderModel {
+				return emptyModel
+			},
+			env:             map[string]string{userEnvVar: "env@raito.io", secretEnvVar: "env-secret"},
+			credentialsFile: credentialsFile,
+			want:            providerCredentials{Domain: "default-domain", User: "env@raito.io", Secret: "env-secret"},
+		},
+		{
+			name: "profile attribute",
+			model: func() RaitoCloudProviderModel {
+				m := emptyModel
+				m.Profile = types.StringValue("e2e")
+
+				return m
+			},
+			env:             map[string]string{profileEnvVar: "default"},
+			credentialsFile: credentialsFile,
+			want:            providerCredentials{Domain: "e2e", User: "e2e@raito.io", Secret: "e2e-secret", UrlOverride: "https://api.raito.dev"},
+		},
+		{
+			name: "profile environment variable",
+			model: func() RaitoCloudProviderModel {
+				return emptyModel
+			},
+			env:             map[string]string{profileEnvVar: "e2e"},
+			credentialsFile: credentialsFile,
+			want:            providerCredentials{Domain: "e2e", User: "e2e@raito.io", Secret: "e2e-secret", UrlOverride: "https://api.raito.dev"},
+		},
+		{
+			name: "unknown explicit profile",
+			model: func() RaitoCloudProviderModel {
+				m := emptyModel
+				m.Profile = types.StringValue("unknown")
+
+				return m
+			},
+			credentialsFile: credentialsFile,
+			wantErr:         `Profile "unknown" could not be loaded`,
+		},
+		{
+			name: "missing credentials names the sources",
+			model: func() RaitoCloudProviderModel {
+				m := emptyModel
+				m.Domain = types.StringValue("config-domain")
+				m.User = types.StringValue("config@raito.io")
+
+				return m
+			},
+			credentialsFile: filepath.Join(t.TempDir(), "does-not-exist"),
+			wantErr:         `Tried provider attribute "secret", environment variable RAITO_SECRET, profile "default" in`,
+		},
+		{
+			name: "unknown configuration value",
+			model: func() RaitoCloudProviderModel {
+				m := emptyModel
+				m.User = types.StringUnknown()
+
+				return m
+			},
+			credentialsFile: credentialsFile,
+			wantErr:         `unknown configuration value for "user"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := &credentialResolver{
+				getenv: func(key string) string {
+					return tt.env[key]
+				},
+				credentialsFile: tt.credentialsFile,
+			}
+
+			model := tt.model()
+			got, diagnostics := resolver.Resolve(&model)
+
+			if tt.wantErr != "" {
+				if !diagnostics.HasError() {
+					t.Fatalf("expected error containing %q, got none", tt.wantErr)
+				}
+
+				for _, d := range diagnostics.Errors() {
+					if strings.Contains(d.Detail(), tt.wantErr) {
+						return
+					}
+				}
+
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, diagnostics)
+			}
+
+			if diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", diagnostics)
+			}
+
+			if got != tt.want {
+				t.Errorf("Resolve() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/retry"
+)
+
+// RaitoCloudProviderRetryModel describes the retry block of the provider.
+type RaitoCloudProviderRetryModel struct {
+	MaxAttempts     types.Int64  `tfsdk:"max_attempts"`
+	BaseDelay       types.String `tfsdk:"base_delay"`
+	MaxDelay        types.String `tfsdk:"max_delay"`
+	RetryableStatus types.List   `tfsdk:"retryable_status"`
+
+	RetryableMutationStatus types.List `tfsdk:"retryable_mutation_status"`
+}
+
+func retrySchemaBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		Attributes: map[string]schema.Attribute{
+			"max_attempts": schema.Int64Attribute{
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Description:         fmt.Sprintf("The maximum number of attempts for a single API call, including the first attempt. Set to 1 to disable retries. Default: %d", retry.DefaultMaxAttempts),
+				MarkdownDescription: fmt.Sprintf("The maximum number of attempts for a single API call, including the first attempt. Set to `1` to disable retries. Default: `%d`", retry.DefaultMaxAttempts),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"base_delay": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Description:         fmt.Sprintf("The delay before the first retry, as a Go duration (e.g. 500ms). The delay doubles for every next retry and is jittered. Default: %s", retry.DefaultBaseDelay),
+				MarkdownDescription: fmt.Sprintf("The delay before the first retry, as a Go duration (e.g. `500ms`). The delay doubles for every next retry and is jittered. Default: `%s`", retry.DefaultBaseDelay),
+			},
+			"max_delay": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Description:         fmt.Sprintf("The maximum delay between two attempts, as a Go duration (e.g. 30s). A Retry-After header returned by Raito Cloud takes precedence. Default: %s", retry.DefaultMaxDelay),
+				MarkdownDescription: fmt.Sprintf("The maximum delay between two attempts, as a Go duration (e.g. `30s`). A `Retry-After` header returned by Raito Cloud takes precedence. Default: `%s`", retry.DefaultMaxDelay),
+			},
+			"retryable_status": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Description:         "The HTTP status codes (e.g. 429) or status classes (e.g. 5xx) that are retried. Default: [\"429\", \"5xx\"]",
+				MarkdownDescription: "The HTTP status codes (e.g. `429`) or status classes (e.g. `5xx`) that are retried. Default: `[\"429\", \"5xx\"]`",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"retryable_mutation_status": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Description:         "The HTTP status codes or status classes on which mutations (e.g. creating an access control) are retried. Mutations are not idempotent, so only statuses for which the server guarantees that the request was not processed should be listed. Default: [\"429\", \"503\"]",
+				MarkdownDescription: "The HTTP status codes or status classes on which mutations (e.g. creating an access control) are retried. A status must also be listed in `retryable_status`. Mutations are not idempotent, so only statuses for which the server guarantees that the request was not processed should be listed. Default: `[\"429\", \"503\"]`",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+		Description:         "Configures how failed API calls are retried. API calls are retried with jittered exponential backoff, honouring the Retry-After header and the operation deadline. Queries are also retried on connection errors, mutations only if the connection failed before the request was sent. The retry configuration applies to the whole provider process. If multiple provider configurations are used, the retry block of the first configured provider applies to all of them.",
+		MarkdownDescription: "Configures how failed API calls are retried. API calls are retried with jittered exponential backoff, honouring the `Retry-After` header and the operation deadline. Queries are also retried on connection errors, mutations only if the connection failed before the request was sent. The retry configuration applies to the whole provider process. If multiple provider configurations are used, the `retry` block of the first configured provider applies to all of them.",
+	}
+}
+
+// ToRetryConfig converts the retry block to a retry.Config. Unset values fall back to the defaults.
+func (m *RaitoCloudProviderRetryModel) ToRetryConfig(ctx context.Context) (_ retry.Config, diagnostics diag.Diagnostics) {
+	config := retry.DefaultConfig()
+
+	if m == nil {
+		return config, diagnostics
+	}
+
+	blockPath := path.Root("retry")
+
+	if !m.MaxAttempts.IsNull() && !m.MaxAttempts.IsUnknown() {
+		config.MaxAttempts = int(m.MaxAttempts.ValueInt64())
+	}
+
+	parseDuration := func(attribute string, value types.String, target *time.Duration) {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+
+		duration, err := time.ParseDuration(value.ValueString())
+		if err != nil || duration < 0 {
+			diagnostics.AddAttributeError(blockPath.AtName(attribute), "Invalid duration", fmt.Sprintf("%q is not a valid positive duration. Use a Go duration like 500ms or 30s.", value.ValueString()))
+
+			return
+		}
+
+		*target = duration
+	}
+
+	parseDuration("base_delay", m.BaseDelay, &config.BaseDelay)
+	parseDuration("max_delay", m.MaxDelay, &config.MaxDelay)
+
+	parseStatusClasses := func(attribute string, value types.List, target *[]retry.StatusClass) {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+
+		var statuses []string
+
+		diagnostics.Append(value.ElementsAs(ctx, &statuses, false)...)
+
+		if diagnostics.HasError() {
+			return
+		}
+
+		statusClasses, err := retry.ParseStatusClasses(statuses)
+		if err != nil {
+			diagnostics.AddAttributeError(blockPath.AtName(attribute), "Invalid retryable status", err.Error())
+
+			return
+		}
+
+		*target = statusClasses
+	}
+
+	parseStatusClasses("retryable_status", m.RetryableStatus, &config.RetryableStatus)
+	parseStatusClasses("retryable_mutation_status", m.RetryableMutationStatus, &config.RetryableMutationStatus)
+
+	return config, diagnostics
+}
+
+var (
+	retryOnce            sync.Once
+	installedRetryConfig retry.Config
+)
+
+// configureRetry makes all API calls retry according to the given configuration and returns the configuration that is in effect.
+// The Raito client does not accept a custom HTTP client, but sends its requests through http.DefaultTransport.
+// The retrying transport is therefore installed once for the whole provider process: the configuration of the first
+// configured provider applies to all provider configurations (aliases) and to all other HTTP calls of the process.
+func configureRetry(config retry.Config) retry.Config {
+	retryOnce.Do(func() {
+		installedRetryConfig = config
+		http.DefaultTransport = retry.NewTransport(http.DefaultTransport, config)
+	})
+
+	return installedRetryConfig
+}
@@ -12,29 +12,20 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"raito": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// providerConfig only sets the test domain. The user and secret are resolved from the RAITO_USER and RAITO_SECRET environment variables.
 var providerConfig = `
-variable "raito_user" {
-	type = string
-}
-
-variable "raito_secret" {
-    type = string
-}
-
 provider "raito" {
   domain       = "e2e"
-  user         = var.raito_user
-  secret       = var.raito_secret
   url_override = "https://api.raito.dev"
 }
 `
 
 func AccProviderPreCheck(t *testing.T) {
-	if v := os.Getenv("TF_VAR_raito_user"); v == "" {
-		t.Fatal("TF_VAR_raito_user must be set for acceptance testing")
+	if v := os.Getenv(userEnvVar); v == "" {
+		t.Fatal(userEnvVar + " must be set for acceptance testing")
 	}
 
-	if v := os.Getenv("TF_VAR_raito_secret"); v == "" {
-		t.Fatal("TF_VAR_raito_secret must be set for acceptance testing")
+	if v := os.Getenv(secretEnvVar); v == "" {
+		t.Fatal(secretEnvVar + " must be set for acceptance testing")
 	}
 }
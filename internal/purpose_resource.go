@@ -0,0 +1,168 @@
+package internal
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/raito-io/sdk-go"
+	raitoType "github.com/raito-io/sdk-go/types"
+	"github.com/raito-io/sdk-go/types/models"
+
+	"github.com/raito-io/terraform-provider-raito/internal/types/abac_expression"
+	"github.com/raito-io/terraform-provider-raito/internal/utils"
+)
+
+var _ resource.Resource = (*PurposeResource)(nil)
+
+type PurposeResourceModel struct {
+	// AccessProviderResourceModel properties. This has to be duplicated because of https://github.com/hashicorp/terraform-plugin-framework/issues/242
+	Id                types.String                        `tfsdk:"id"`
+	Name              types.String                        `tfsdk:"name"`
+	Description       types.String                        `tfsdk:"description"`
+	State             types.String                        `tfsdk:"state"`
+	Who               types.Set                           `tfsdk:"who"`
+	Owners            types.Set                           `tfsdk:"owners"`
+	WhoAbacRule       abac_expression.AbacRuleValue       `tfsdk:"who_abac_rule"`
+	WhoAbac           types.Object                        `tfsdk:"who_abac"`
+	WhoAbacExpression abac_expression.AbacExpressionValue `tfsdk:"who_abac_expression"`
+	WhoLocked         types.Bool                          `tfsdk:"who_locked"`
+	InheritanceLocked types.Bool                          `tfsdk:"inheritance_locked"`
+	Locks             types.Set                           `tfsdk:"locks"`
+	LockReason        types.String                        `tfsdk:"lock_reason"`
+	Timeouts          timeouts.Value                      `tfsdk:"timeouts"`
+
+	// PurposeResourceModel properties.
+	WhatLocked types.Bool `tfsdk:"what_locked"`
+}
+
+func (p *PurposeResourceModel) GetAccessProviderResourceModel() *AccessProviderResourceModel {
+	return &AccessProviderResourceModel{
+		Id:                p.Id,
+		Name:              p.Name,
+		Description:       p.Description,
+		State:             p.State,
+		Who:               p.Who,
+		Owners:            p.Owners,
+		WhoAbacRule:       p.WhoAbacRule,
+		WhoAbac:           p.WhoAbac,
+		WhoAbacExpression: p.WhoAbacExpression,
+		WhoLocked:         p.WhoLocked,
+		InheritanceLocked: p.InheritanceLocked,
+		Locks:             p.Locks,
+		LockReason:        p.LockReason,
+		Timeouts:          p.Timeouts,
+	}
+}
+
+func (p *PurposeResourceModel) SetAccessProviderResourceModel(ap *AccessProviderResourceModel) {
+	p.Id = ap.Id
+	p.Name = ap.Name
+	p.Description = ap.Description
+	p.State = ap.State
+	p.Who = ap.Who
+	p.Owners = ap.Owners
+	p.WhoAbacRule = ap.WhoAbacRule
+	p.WhoAbac = ap.WhoAbac
+	p.WhoAbacExpression = ap.WhoAbacExpression
+	p.WhoLocked = ap.WhoLocked
+	p.InheritanceLocked = ap.InheritanceLocked
+	p.Locks = ap.Locks
+	p.LockReason = ap.LockReason
+	p.Timeouts = ap.Timeouts
+}
+
+func (p *PurposeResourceModel) ToAccessProviderInput(ctx context.Context, client *sdk.RaitoClient, result *raitoType.AccessProviderInput) diag.Diagnostics {
+	diagnostics := p.GetAccessProviderResourceModel().ToAccessProviderInput(ctx, client, result)
+
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	result.Action = utils.Ptr(models.AccessProviderActionPurpose)
+
+	if p.WhatLocked.ValueBool() {
+		result.Locks = append(result.Locks, raitoType.AccessProviderLockDataInput{
+			LockKey: raitoType.AccessProviderLockWhatlock,
+			Details: &raitoType.AccessProviderLockDetailsInput{
+				Reason: utils.Ptr(lockMsg),
+			},
+		})
+	}
+
+	return diagnostics
+}
+
+func (p *PurposeResourceModel) FromAccessProvider(_ context.Context, _ *sdk.RaitoClient, input *raitoType.AccessProvider) diag.Diagnostics {
+	apResourceModel := p.GetAccessProviderResourceModel()
+	diagnostics := apResourceModel.FromAccessProvider(input)
+
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	p.SetAccessProviderResourceModel(apResourceModel)
+
+	p.WhatLocked = types.BoolValue(slices.ContainsFunc(input.Locks, func(data raitoType.AccessProviderLocksAccessProviderLockData) bool {
+		return data.LockKey == raitoType.AccessProviderLockWhatlock
+	}))
+
+	return diagnostics
+}
+
+func (p *PurposeResourceModel) UpdateOwners(owners types.Set) {
+	p.Owners = owners
+}
+
+type PurposeResource struct {
+	AccessProviderResource[PurposeResourceModel, *PurposeResourceModel]
+}
+
+func NewPurposeResource() resource.Resource {
+	return &PurposeResource{
+		AccessProviderResource: AccessProviderResource[PurposeResourceModel, *PurposeResourceModel]{
+			resourceType: "raito_purpose",
+			planModifierHooks: []PlanModifierHook[PurposeResourceModel, *PurposeResourceModel]{
+				purposeModifyPlan,
+			},
+		},
+	}
+}
+
+func (p *PurposeResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_purpose"
+}
+
+func (p *PurposeResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	attributes := p.schema("purpose")
+	attributes["what_locked"] = schema.BoolAttribute{
+		Required:            false,
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           false,
+		Description:         "Indicates whether it should lock the what of the purpose. The what of a purpose consists of the grants that inherit from it.",
+		MarkdownDescription: "Indicates whether it should lock the what of the purpose. The what of a purpose consists of the grants that inherit from it, by referring to the purpose as `access_control` in their who-items.",
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+		Description:         "The purpose access control resource",
+		MarkdownDescription: "The resource for representing a Raito Purpose access control. Grants inherit the who-items of a purpose by referring to the purpose as `access_control` in their who-items.",
+		Version:             1,
+	}
+}
+
+func purposeModifyPlan(_ context.Context, data *PurposeResourceModel) (_ *PurposeResourceModel, diagnostics diag.Diagnostics) {
+	if data.WhatLocked.IsUnknown() {
+		data.WhatLocked = types.BoolValue(false)
+	}
+
+	return data, diagnostics
+}
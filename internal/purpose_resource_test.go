@@ -0,0 +1,154 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccPurposeResource(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest: false,
+			PreCheck: func() {
+				AccProviderPreCheck(t)
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_0_0),
+			},
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+resource "raito_purpose" "test" {
+	name        = "tfTestPurpose"
+    description = "test description"
+	who = [
+		{
+			"user": "terraform@raito.io"
+		}
+	]
+}
+`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("raito_purpose.test", "name", "tfTestPurpose"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "description", "test description"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "state", "Active"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "who.#", "1"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "who.0.user", "terraform@raito.io"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "who_locked", "true"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "inheritance_locked", "false"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "what_locked", "false"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "locks.#", "1"),
+						resource.TestCheckTypeSetElemAttr("raito_purpose.test", "locks.*", "name"),
+					),
+				},
+				{
+					ResourceName:            "raito_purpose.test",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"who"},
+				},
+				{
+					Config: providerConfig + `
+resource "raito_purpose" "test" {
+	name        = "tfTestPurpose"
+    description = "updated description"
+	what_locked = true
+	locks       = ["name", "delete"]
+	lock_reason = "Managed by {resource_type} {name} in workspace {workspace}"
+	timeouts {
+		update = "5m"
+	}
+	who = [
+		{
+			"user": "terraform@raito.io"
+		},
+		{
+			"user": "c_harris@raito.io"
+			"expires_at": "2099-01-01T00:00:00Z"
+		}
+	]
+}
+`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("raito_purpose.test", "name", "tfTestPurpose"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "description", "updated description"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "who.#", "2"),
+						resource.TestCheckTypeSetElemNestedAttrs("raito_purpose.test", "who.*", map[string]string{
+							"user":       "c_harris@raito.io",
+							"expires_at": "2099-01-01T00:00:00Z",
+						}),
+						resource.TestCheckResourceAttr("raito_purpose.test", "who_locked", "true"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "what_locked", "true"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "locks.#", "2"),
+						resource.TestCheckTypeSetElemAttr("raito_purpose.test", "locks.*", "delete"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "lock_reason", "Managed by {resource_type} {name} in workspace {workspace}"),
+						resource.TestCheckResourceAttr("raito_purpose.test", "timeouts.update", "5m"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("inherited by grant", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest: false,
+			PreCheck: func() {
+				AccProviderPreCheck(t)
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_0_0),
+			},
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+data "raito_datasource" "ds" {
+    name = "Snowflake"
+}
+
+resource "raito_purpose" "test" {
+	name        = "tfTestPurposeInheritance"
+    description = "purpose inherited by a grant"
+	who = [
+		{
+			"user": "terraform@raito.io"
+		}
+	]
+}
+
+resource "raito_grant" "test" {
+	name        = "tfTestPurposeGrant"
+    description = "grant inheriting from a purpose"
+	data_source = [
+		{
+			data_source = data.raito_datasource.ds.id
+			type = "role"
+		}
+	]
+	what_data_objects = [
+		{
+			fullname = "MASTER_DATA.SALES"
+			data_source = data.raito_datasource.ds.id
+		}
+	]
+	who = [
+		{
+			access_control = raito_purpose.test.id
+		}
+	]
+}
+`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("raito_purpose.test", "name", "tfTestPurposeInheritance"),
+						resource.TestCheckResourceAttr("raito_grant.test", "who.#", "1"),
+						resource.TestCheckResourceAttrPair("raito_grant.test", "who.0.access_control", "raito_purpose.test", "id"),
+						resource.TestCheckResourceAttr("raito_grant.test", "inheritance_locked", "true"),
+					),
+				},
+			},
+		})
+	})
+}
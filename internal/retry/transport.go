@@ -0,0 +1,345 @@
+package retry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	DefaultMaxAttempts = 3
+	DefaultBaseDelay   = 500 * time.Millisecond
+	DefaultMaxDelay    = 30 * time.Second
+)
+
+// DefaultRetryableStatus are the status classes that are retried if nothing else is configured.
+var DefaultRetryableStatus = []string{"429", "5xx"}
+
+// DefaultRetryableMutationStatus are the status codes on which a GraphQL mutation is retried if nothing else is configured.
+// Both indicate that the server did not process the request, so retrying cannot apply the mutation twice.
+var DefaultRetryableMutationStatus = []string{"429", "503"}
+
+// StatusClass matches a single HTTP status code (e.g. 429) or a class of status codes (e.g. 5xx).
+type StatusClass struct {
+	min int
+	max int
+}
+
+// ParseStatusClass parses a status code like "429" or a status class like "5xx".
+func ParseStatusClass(value string) (StatusClass, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if len(value) == 3 && strings.HasSuffix(value, "xx") {
+		class, err := strconv.Atoi(value[:1])
+		if err != nil || class < 1 || class > 5 {
+			return StatusClass{}, fmt.Errorf("invalid status class %q", value)
+		}
+
+		return StatusClass{min: class * 100, max: class*100 + 99}, nil
+	}
+
+	code, err := strconv.Atoi(value)
+	if err != nil || code < 100 || code > 599 {
+		return StatusClass{}, fmt.Errorf("invalid status code %q", value)
+	}
+
+	return StatusClass{min: code, max: code}, nil
+}
+
+// ParseStatusClasses parses a list of status codes and status classes.
+func ParseStatusClasses(values []string) ([]StatusClass, error) {
+	result := make([]StatusClass, 0, len(values))
+
+	for _, value := range values {
+		class, err := ParseStatusClass(value)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, class)
+	}
+
+	return result, nil
+}
+
+func (c StatusClass) Matches(statusCode int) bool {
+	return statusCode >= c.min && statusCode <= c.max
+}
+
+// Config defines when and how often a request is retried.
+type Config struct {
+	// MaxAttempts is the total number of attempts, including the first one. A value of 1 disables retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. The delay doubles for every next retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential delay. A Retry-After header sent by the server is not capped.
+	MaxDelay time.Duration
+	// RetryableStatus are the response statuses that are retried.
+	RetryableStatus []StatusClass
+	// RetryableMutationStatus are the response statuses on which a GraphQL mutation is retried.
+	// Mutations are not idempotent, so they are only retried if the status is in both RetryableStatus and RetryableMutationStatus.
+	RetryableMutationStatus []StatusClass
+}
+
+// DefaultConfig returns the retry configuration that is used if nothing else is configured.
+func DefaultConfig() Config {
+	retryableStatus, _ := ParseStatusClasses(DefaultRetryableStatus)
+	retryableMutationStatus, _ := ParseStatusClasses(DefaultRetryableMutationStatus)
+
+	return Config{
+		MaxAttempts:             DefaultMaxAttempts,
+		BaseDelay:               DefaultBaseDelay,
+		MaxDelay:                DefaultMaxDelay,
+		RetryableStatus:         retryableStatus,
+		RetryableMutationStatus: retryableMutationStatus,
+	}
+}
+
+// Transport is a http.RoundTripper that retries requests with a retryable response status or a transient connection error.
+// It waits with jittered exponential backoff between attempts, honours the Retry-After header
+// and gives up as soon as the next attempt would exceed the deadline of the request context.
+//
+// GraphQL mutations are not idempotent. They are only retried on a status in RetryableMutationStatus
+// or on a connection error that occurred before the request was sent.
+type Transport struct {
+	Base   http.RoundTripper
+	Config Config
+
+	// jitter returns a random value in [0, 1). Overridden in tests.
+	jitter func() float64
+}
+
+func NewTransport(base http.RoundTripper, config Config) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &Transport{
+		Base:   base,
+		Config: config,
+		jitter: rand.Float64,
+	}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	mutation := isGraphqlMutation(body)
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+
+		if body != nil {
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+			attemptReq.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+
+		resp, roundTripErr := t.Base.RoundTrip(attemptReq)
+		if roundTripErr != nil {
+			if attempt >= t.Config.MaxAttempts || ctx.Err() != nil || !isRetryableError(roundTripErr, mutation) {
+				return nil, roundTripErr //nolint:wrapcheck
+			}
+
+			delay := t.delay(attempt, nil)
+
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+				return nil, roundTripErr //nolint:wrapcheck
+			}
+
+			if waitErr := wait(ctx, delay); waitErr != nil {
+				return nil, waitErr
+			}
+
+			continue
+		}
+
+		if attempt >= t.Config.MaxAttempts || !t.isRetryable(resp.StatusCode, mutation) {
+			return resp, nil
+		}
+
+		delay := t.delay(attempt, resp)
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return resp, nil
+		}
+
+		// Drain the body so the connection can be reused.
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		_ = resp.Body.Close()
+
+		if waitErr := wait(ctx, delay); waitErr != nil {
+			return nil, waitErr
+		}
+	}
+}
+
+func (t *Transport) isRetryable(statusCode int, mutation bool) bool {
+	if !matchesAny(t.Config.RetryableStatus, statusCode) {
+		return false
+	}
+
+	return !mutation || matchesAny(t.Config.RetryableMutationStatus, statusCode)
+}
+
+func matchesAny(classes []StatusClass, statusCode int) bool {
+	for _, class := range classes {
+		if class.Matches(statusCode) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRetryableError returns true if the round trip error is transient.
+// Queries are retried on any connection error. Mutations are only retried if the request was never sent.
+func isRetryableError(err error, mutation bool) bool {
+	if notSent(err) {
+		return true
+	}
+
+	if mutation {
+		return false
+	}
+
+	var netErr net.Error
+
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) ||
+		(errors.As(err, &netErr) && netErr.Timeout())
+}
+
+// notSent returns true if the error occurred while setting up the connection, so the request never reached the server.
+func notSent(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	// The TLS handshake completes before any part of the request is written.
+	// net/http does not export this error type, so it can only be recognised by its message.
+	return errors.Is(err, syscall.ECONNREFUSED) || strings.Contains(err.Error(), "TLS handshake timeout")
+}
+
+// isGraphqlMutation returns true if the request body is a GraphQL request of which the operation is a mutation.
+func isGraphqlMutation(body []byte) bool {
+	if len(body) == 0 {
+		return false
+	}
+
+	var request struct {
+		Query string `json:"query"`
+	}
+
+	if err := json.Unmarshal(body, &request); err != nil {
+		return false
+	}
+
+	for _, line := range strings.Split(request.Query, "\n") {
+		line = strings.TrimSpace(line)
+
+		// Skip empty lines and comments before the operation
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		return strings.HasPrefix(line, "mutation")
+	}
+
+	return false
+}
+
+// delay returns the time to wait before the next attempt. A valid Retry-After header takes precedence over the exponential backoff.
+// resp is nil if the previous attempt failed without a response.
+func (t *Transport) delay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return retryAfter
+		}
+	}
+
+	backoff := float64(t.Config.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if t.Config.MaxDelay > 0 && backoff > float64(t.Config.MaxDelay) {
+		backoff = float64(t.Config.MaxDelay)
+	}
+
+	// Full jitter
+	return time.Duration(t.jitter() * backoff)
+}
+
+// parseRetryAfter parses a Retry-After header value, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := date.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// readBody reads and closes the complete request body, so it can be inspected and replayed for every attempt.
+// It returns nil if the request has no body.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	defer req.Body.Close()
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read request body: %w", err)
+	}
+
+	return body, nil
+}
+
+func wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,424 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+const testMutation = `{"query":"mutation createAccessProvider($ap: AccessProviderInput!) { createAccessProvider(ap: $ap) { id } }"}`
+
+// roundTripFunc is a http.RoundTripper that calls the function for every round trip.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func testTransport(config Config) *Transport {
+	transport := NewTransport(http.DefaultTransport, config)
+	transport.jitter = func() float64 { return 1 }
+
+	return transport
+}
+
+func testConfig(maxAttempts int) Config {
+	config := DefaultConfig()
+	config.MaxAttempts = maxAttempts
+	config.BaseDelay = time.Millisecond
+	config.MaxDelay = 5 * time.Millisecond
+
+	return config
+}
+
+func TestTransport_RetriesRetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"query":"q"}` {
+			t.Errorf("unexpected body on attempt %d: %q", calls.Load()+1, body)
+		}
+
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: testTransport(testConfig(3))}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"query":"q"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 calls, got %d", calls.Load())
+	}
+}
+
+func TestTransport_ReplaysBodyWithoutGetBody(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("unexpected body: %q", body)
+		}
+
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, io.NopCloser(strings.NewReader("payload")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := testTransport(testConfig(2)).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || calls.Load() != 2 {
+		t.Errorf("expected status 200 after 2 calls, got %d after %d calls", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestTransport_DoesNotRetryOtherStatus(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: testTransport(testConfig(3))}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest || calls.Load() != 1 {
+		t.Errorf("expected a single call with status 400, got %d after %d calls", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestTransport_StopsAfterMaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: testTransport(testConfig(4))}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway || calls.Load() != 4 {
+		t.Errorf("expected 4 calls ending in status 502, got %d after %d calls", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestTransport_HonoursRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	var firstCall time.Time
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			firstCall = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		if elapsed := time.Since(firstCall); elapsed < time.Second {
+			t.Errorf("expected to wait at least 1s as requested by Retry-After, waited %s", elapsed)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: testTransport(testConfig(2))}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || calls.Load() != 2 {
+		t.Errorf("expected status 200 after 2 calls, got %d after %d calls", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestTransport_HonoursContextDeadline(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+
+	resp, err := (&http.Client{Transport: testTransport(testConfig(5))}).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("expected to give up immediately as Retry-After exceeds the deadline, took %s", time.Since(start))
+	}
+
+	if resp.StatusCode != http.StatusServiceUnavailable || calls.Load() != 1 {
+		t.Errorf("expected a single call with status 503, got %d after %d calls", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestTransport_ContextCancelledWhileWaiting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	config := testConfig(5)
+	config.BaseDelay = time.Minute
+	config.MaxDelay = time.Minute
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err = (&http.Client{Transport: testTransport(config)}).Do(req) //nolint:bodyclose
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestTransport_RetriesMutationOnlyOnRetryableMutationStatus(t *testing.T) {
+	tests := []struct {
+		status    int
+		wantCalls int32
+	}{
+		{status: http.StatusServiceUnavailable, wantCalls: 3},
+		{status: http.StatusTooManyRequests, wantCalls: 3},
+		{status: http.StatusBadGateway, wantCalls: 1},
+		{status: http.StatusGatewayTimeout, wantCalls: 1},
+		{status: http.StatusInternalServerError, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			var calls atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: testTransport(testConfig(3))}
+
+			resp, err := client.Post(server.URL, "application/json", strings.NewReader(testMutation))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, calls.Load())
+			}
+		})
+	}
+}
+
+func TestTransport_ConnectionErrors(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	resetErr := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+
+	tests := []struct {
+		name      string
+		body      string
+		err       error
+		wantCalls int32
+	}{
+		{name: "query after dial error", body: `{"query":"query { currentUser { id } }"}`, err: dialErr, wantCalls: 2},
+		{name: "query after connection reset", body: `{"query":"query { currentUser { id } }"}`, err: resetErr, wantCalls: 2},
+		{name: "query after EOF", body: `{"query":"query { currentUser { id } }"}`, err: io.EOF, wantCalls: 2},
+		{name: "mutation after dial error", body: testMutation, err: dialErr, wantCalls: 2},
+		{name: "mutation after TLS handshake timeout", body: testMutation, err: errors.New("net/http: TLS handshake timeout"), wantCalls: 2},
+		{name: "mutation after connection reset", body: testMutation, err: resetErr, wantCalls: 1},
+		{name: "mutation after EOF", body: testMutation, err: io.EOF, wantCalls: 1},
+		{name: "query after other error", body: `{"query":"query { currentUser { id } }"}`, err: errors.New("unsupported protocol scheme"), wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+
+			base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				body, _ := io.ReadAll(req.Body)
+				if string(body) != tt.body {
+					t.Errorf("unexpected body on attempt %d: %q", calls.Load()+1, body)
+				}
+
+				if calls.Add(1) == 1 {
+					return nil, tt.err
+				}
+
+				return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+			})
+
+			transport := NewTransport(base, testConfig(3))
+			transport.jitter = func() float64 { return 1 }
+
+			req, err := http.NewRequest(http.MethodPost, "http://raito.test/query", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := transport.RoundTrip(req)
+			if resp != nil {
+				defer resp.Body.Close()
+			}
+
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, calls.Load())
+			}
+
+			if tt.wantCalls == 1 && !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestIsGraphqlMutation(t *testing.T) {
+	tests := []struct {
+		body string
+		want bool
+	}{
+		{body: testMutation, want: true},
+		{body: `{"query":"# create the grant\n  mutation { createAccessProvider { id } }"}`, want: true},
+		{body: `{"query":"query { accessProvider(id: \"1\") { id } }"}`, want: false},
+		{body: `{"query":"{ currentUser { id } }"}`, want: false},
+		{body: `not json`, want: false},
+		{body: ``, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := isGraphqlMutation([]byte(tt.body)); got != tt.want {
+			t.Errorf("isGraphqlMutation(%q) = %t, want %t", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestParseStatusClass(t *testing.T) {
+	tests := []struct {
+		value   string
+		matches []int
+		misses  []int
+		wantErr bool
+	}{
+		{value: "5xx", matches: []int{500, 503, 599}, misses: []int{429, 600}},
+		{value: "429", matches: []int{429}, misses: []int{428, 430}},
+		{value: "4XX", matches: []int{400, 499}, misses: []int{500}},
+		{value: "6xx", wantErr: true},
+		{value: "abc", wantErr: true},
+		{value: "99", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			class, err := ParseStatusClass(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseStatusClass(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+
+			for _, code := range tt.matches {
+				if !class.Matches(code) {
+					t.Errorf("expected %q to match %d", tt.value, code)
+				}
+			}
+
+			for _, code := range tt.misses {
+				if class.Matches(code) {
+					t.Errorf("expected %q not to match %d", tt.value, code)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		{value: "", wantOk: false},
+		{value: "5", want: 5 * time.Second, wantOk: true},
+		{value: "-1", wantOk: false},
+		{value: now.Add(10 * time.Second).Format(http.TimeFormat), want: 10 * time.Second, wantOk: true},
+		{value: now.Add(-10 * time.Second).Format(http.TimeFormat), want: 0, wantOk: true},
+		{value: "soon", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value, now)
+		if ok != tt.wantOk || got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %s, %t; want %s, %t", tt.value, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
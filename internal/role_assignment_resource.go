@@ -0,0 +1,455 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/raito-io/sdk-go"
+	raitoType "github.com/raito-io/sdk-go/types"
+)
+
+var _ resource.Resource = (*RoleAssignmentResource)(nil)
+var _ resource.ResourceWithImportState = (*RoleAssignmentResource)(nil)
+
+const (
+	roleAssignmentResourceTypeAccessProvider = "access_provider"
+	roleAssignmentResourceTypeDataSource     = "data_source"
+	roleAssignmentResourceTypeIdentityStore  = "identity_store"
+)
+
+// roleAssignmentLocks serializes the updates of the assignees of a role on a resource.
+// Raito Cloud only supports replacing all assignees at once, so parallel creates and deletes of role assignments
+// on the same resource and role would otherwise overwrite each other.
+var roleAssignmentLocks = keyedMutex{}
+
+// keyedMutex is a set of mutexes identified by a key. Mutexes are removed once no one holds or waits for them.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedMutexEntry
+}
+
+type keyedMutexEntry struct {
+	mu       sync.Mutex
+	refCount int
+}
+
+// Lock locks the mutex of the given key and returns the function that unlocks it.
+func (k *keyedMutex) Lock(key string) func() {
+	k.mu.Lock()
+
+	if k.locks == nil {
+		k.locks = make(map[string]*keyedMutexEntry)
+	}
+
+	entry, found := k.locks[key]
+	if !found {
+		entry = &keyedMutexEntry{}
+		k.locks[key] = entry
+	}
+
+	entry.refCount++
+	k.mu.Unlock()
+
+	entry.mu.Lock()
+
+	return func() {
+		entry.mu.Unlock()
+
+		k.mu.Lock()
+		defer k.mu.Unlock()
+
+		entry.refCount--
+		if entry.refCount == 0 {
+			delete(k.locks, key)
+		}
+	}
+}
+
+type RoleAssignmentModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Role         types.String   `tfsdk:"role"`
+	ResourceType types.String   `tfsdk:"resource_type"`
+	ResourceId   types.String   `tfsdk:"resource_id"`
+	User         types.String   `tfsdk:"user"`
+	Group        types.String   `tfsdk:"group"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *RoleAssignmentModel) GetRoleId() string {
+	return roleId(m.Role.ValueString())
+}
+
+// GetAssigneeKey returns the assignee of the role, prefixed with its type (user: or group:).
+func (m *RoleAssignmentModel) GetAssigneeKey() string {
+	if !m.User.IsNull() {
+		return _userPrefix(m.User.ValueString())
+	}
+
+	return _groupPrefix(m.Group.ValueString())
+}
+
+// GetAssigneeId returns the ID of the user or group the role is assigned to.
+func (m *RoleAssignmentModel) GetAssigneeId() string {
+	if !m.User.IsNull() {
+		return m.User.ValueString()
+	}
+
+	return m.Group.ValueString()
+}
+
+// GetLockKey returns the key of the lock that must be held while updating the assignees of the role on the resource.
+func (m *RoleAssignmentModel) GetLockKey() string {
+	return m.ResourceType.ValueString() + _separator + m.ResourceId.ValueString() + _separator + m.Role.ValueString()
+}
+
+func _generateRoleAssignmentId(resourceType, resourceId, role, assigneeKey string) string {
+	return resourceType + _separator + resourceId + _separator + role + _separator + assigneeKey
+}
+
+func _getRoleAssignmentFromId(id string) (resourceType, resourceId, role, assigneeKey string, err error) {
+	parts := strings.SplitN(id, _separator, 4)
+	if len(parts) != 4 {
+		return "", "", "", "", fmt.Errorf("invalid role assignment id %q: expected format <resource_type>%[2]s<resource_id>%[2]s<role>%[2]s<user:id|group:id>", id, _separator)
+	}
+
+	if !strings.HasPrefix(parts[3], _userPrefix("")) && !strings.HasPrefix(parts[3], _groupPrefix("")) {
+		return "", "", "", "", fmt.Errorf("invalid role assignment id %q: assignee should be prefixed with %q or %q", id, _userPrefix(""), _groupPrefix(""))
+	}
+
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+type RoleAssignmentResource struct {
+	client *sdk.RaitoClient
+}
+
+func NewRoleAssignmentResource() resource.Resource {
+	return &RoleAssignmentResource{}
+}
+
+func (r *RoleAssignmentResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_role_assignment"
+}
+
+func (r *RoleAssignmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Sensitive:           false,
+				Description:         "Generated ID of the role assignment",
+				MarkdownDescription: "Generated ID of the role assignment",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The name of the role (e.g. Owner)",
+				MarkdownDescription: "The name of the role (e.g. `Owner`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The type of the resource on which the role is assigned",
+				MarkdownDescription: fmt.Sprintf("The type of the resource on which the role is assigned. Possible values are: [%q, %q, %q]", roleAssignmentResourceTypeAccessProvider, roleAssignmentResourceTypeDataSource, roleAssignmentResourceTypeIdentityStore),
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleAssignmentResourceTypeAccessProvider, roleAssignmentResourceTypeDataSource, roleAssignmentResourceTypeIdentityStore),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The ID of the resource on which the role is assigned",
+				MarkdownDescription: "The ID of the resource on which the role is assigned",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The ID of the user to which the role is assigned",
+				MarkdownDescription: "The ID of the user to which the role is assigned. Exactly one of `user` or `group` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("group")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Sensitive:           false,
+				Description:         "The ID of the group to which the role is assigned",
+				MarkdownDescription: "The ID of the group to which the role is assigned. Exactly one of `user` or `group` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+		Description:         "Role assignment on a single resource",
+		MarkdownDescription: "Non-authoritative assignment of a role on a single access provider, data source or identity store to a user or group. Other assignees of the role are left untouched. Use `raito_global_role_assignment` for global roles. Do not combine an `Owner` role assignment with the `owners` attribute of the same resource, as that attribute is authoritative.",
+		Version:             1,
+	}
+}
+
+func (r *RoleAssignmentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data RoleAssignmentModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diagnostics := data.Timeouts.Create(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "create", createTimeout, &response.Diagnostics)
+	defer done()
+
+	unlock := roleAssignmentLocks.Lock(data.GetLockKey())
+	defer unlock()
+
+	users, groups, err := listRoleAssignees(ctx, data.ResourceId.ValueString(), data.GetRoleId(), r.client)
+	if err != nil {
+		response.Diagnostics.AddError("Failed to list role assignees", err.Error())
+
+		return
+	}
+
+	assignees := append(users, groups...)
+
+	if !slices.Contains(assignees, data.GetAssigneeId()) {
+		err = r.updateRoleAssignees(ctx, &data, append(assignees, data.GetAssigneeId()))
+		if err != nil {
+			response.Diagnostics.AddError("Failed to assign role", err.Error())
+
+			return
+		}
+	}
+
+	data.Id = types.StringValue(_generateRoleAssignmentId(data.ResourceType.ValueString(), data.ResourceId.ValueString(), data.Role.ValueString(), data.GetAssigneeKey()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *RoleAssignmentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var stateData RoleAssignmentModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diagnostics := stateData.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
+	resourceType, resourceId, role, assigneeKey, err := _getRoleAssignmentFromId(stateData.Id.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Failed to parse role assignment id", err.Error())
+
+		return
+	}
+
+	users, groups, err := listRoleAssignees(ctx, resourceId, roleId(role), r.client)
+	if err != nil {
+		var notFoundErr *raitoType.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			response.State.RemoveResource(ctx)
+		} else {
+			response.Diagnostics.AddError("Failed to list role assignees", err.Error())
+		}
+
+		return
+	}
+
+	stateData.User = types.StringNull()
+	stateData.Group = types.StringNull()
+
+	if userId, isUser := strings.CutPrefix(assigneeKey, _userPrefix("")); isUser && slices.Contains(users, userId) {
+		stateData.User = types.StringValue(userId)
+	} else if groupId, isGroup := strings.CutPrefix(assigneeKey, _groupPrefix("")); isGroup && slices.Contains(groups, groupId) {
+		stateData.Group = types.StringValue(groupId)
+	} else {
+		// Role assignment was removed outside of terraform
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	stateData.ResourceType = types.StringValue(resourceType)
+	stateData.ResourceId = types.StringValue(resourceId)
+	stateData.Role = types.StringValue(role)
+
+	response.Diagnostics.Append(response.State.Set(ctx, stateData)...)
+}
+
+func (r *RoleAssignmentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var planData, stateData RoleAssignmentModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// All attributes require a replacement, so only the timeouts can be updated in place.
+	if !planData.Role.Equal(stateData.Role) || !planData.ResourceType.Equal(stateData.ResourceType) || !planData.ResourceId.Equal(stateData.ResourceId) || !planData.User.Equal(stateData.User) || !planData.Group.Equal(stateData.Group) {
+		response.Diagnostics.AddError("Not able to update role assignment", "Not able to update role assignment")
+
+		return
+	}
+
+	stateData.Timeouts = planData.Timeouts
+
+	response.Diagnostics.Append(response.State.Set(ctx, stateData)...)
+}
+
+func (r *RoleAssignmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data RoleAssignmentModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diagnostics := data.Timeouts.Delete(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "delete", deleteTimeout, &response.Diagnostics)
+	defer done()
+
+	unlock := roleAssignmentLocks.Lock(data.GetLockKey())
+	defer unlock()
+
+	users, groups, err := listRoleAssignees(ctx, data.ResourceId.ValueString(), data.GetRoleId(), r.client)
+	if err != nil {
+		var notFoundErr *raitoType.ErrNotFound
+		if !errors.As(err, &notFoundErr) {
+			response.Diagnostics.AddError("Failed to list role assignees", err.Error())
+
+			return
+		}
+	}
+
+	assignees := append(users, groups...)
+
+	if slices.Contains(assignees, data.GetAssigneeId()) {
+		assignees = slices.DeleteFunc(assignees, func(assignee string) bool {
+			return assignee == data.GetAssigneeId()
+		})
+
+		err = r.updateRoleAssignees(ctx, &data, assignees)
+		if err != nil {
+			response.Diagnostics.AddError("Failed to unassign role", err.Error())
+
+			return
+		}
+	}
+
+	response.State.RemoveResource(ctx)
+}
+
+// updateRoleAssignees replaces all assignees of the role on the resource of the role assignment.
+func (r *RoleAssignmentResource) updateRoleAssignees(ctx context.Context, data *RoleAssignmentModel, assignees []string) error {
+	var err error
+
+	switch data.ResourceType.ValueString() {
+	case roleAssignmentResourceTypeAccessProvider:
+		_, err = r.client.Role().UpdateRoleAssigneesOnAccessProvider(ctx, data.ResourceId.ValueString(), data.GetRoleId(), assignees...)
+	case roleAssignmentResourceTypeDataSource:
+		_, err = r.client.Role().UpdateRoleAssigneesOnDataSource(ctx, data.ResourceId.ValueString(), data.GetRoleId(), assignees...)
+	case roleAssignmentResourceTypeIdentityStore:
+		_, err = r.client.Role().UpdateRoleAssigneesOnIdentityStore(ctx, data.ResourceId.ValueString(), data.GetRoleId(), assignees...)
+	default:
+		err = fmt.Errorf("unsupported resource type %q", data.ResourceType.ValueString())
+	}
+
+	return err
+}
+
+func (r *RoleAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*RaitoResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *internal.RaitoResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if providerData == nil || providerData.Client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *sdk.RaitoClient, not to be nil.",
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *RoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
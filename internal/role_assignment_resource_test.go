@@ -0,0 +1,210 @@
+package internal
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+)
+
+func TestRoleAssignmentId(t *testing.T) {
+	id := _generateRoleAssignmentId("data_source", "ds1", "Owner", _groupPrefix("group1"))
+
+	resourceType, resourceId, role, assignee, err := _getRoleAssignmentFromId(id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resourceType != "data_source" || resourceId != "ds1" || role != "Owner" || assignee != "group:group1" {
+		t.Errorf("expected (data_source, ds1, Owner, group:group1), got (%s, %s, %s, %s)", resourceType, resourceId, role, assignee)
+	}
+
+	if _, _, _, _, err = _getRoleAssignmentFromId("ds1#Owner#user:user1"); err == nil {
+		t.Errorf("expected error for id without resource type")
+	}
+
+	if _, _, _, _, err = _getRoleAssignmentFromId("data_source#ds1#Owner#user1"); err == nil {
+		t.Errorf("expected error for id without assignee prefix")
+	}
+}
+
+func TestKeyedMutex(t *testing.T) {
+	var locks keyedMutex
+
+	var wg sync.WaitGroup
+
+	counter := 0
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			unlock := locks.Lock("access_provider#ap1#Owner")
+			defer unlock()
+
+			// Not atomic on purpose: the race detector and the final count catch missing locking.
+			current := counter
+			counter = current + 1
+		}()
+	}
+
+	wg.Wait()
+
+	if counter != 50 {
+		t.Errorf("expected counter 50, got %d", counter)
+	}
+
+	unlock := locks.Lock("access_provider#ap1#Owner")
+	unlockOther := locks.Lock("access_provider#ap2#Owner") // Other keys are not blocked
+	unlockOther()
+	unlock()
+
+	if len(locks.locks) != 0 {
+		t.Errorf("expected all locks to be removed, got %d", len(locks.locks))
+	}
+}
+
+func TestAccRoleAssignmentResource(t *testing.T) {
+	testId := gonanoid.Must(8)
+
+	t.Run("basic", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest: false,
+			PreCheck: func() {
+				AccProviderPreCheck(t)
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_0_0),
+			},
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + fmt.Sprintf(`
+data "raito_identitystore" "raito" {
+	name = "Raito"
+}
+
+data "raito_datasource" "ds" {
+	name = "Snowflake"
+}
+
+resource "raito_user" "u1" {
+	name = "ra-tfTestUser-%[1]s"
+	email = "ra-test-user-%[1]s@raito.io"
+	raito_user = true
+}
+
+resource "raito_group" "g1" {
+	name = "ra-tfTestGroup-%[1]s"
+	identity_store = data.raito_identitystore.raito.id
+}
+
+resource "raito_grant" "test" {
+	name        = "ra-tfTestGrant-%[1]s"
+	description = "role assignment test"
+	data_source = [
+		{
+			data_source = data.raito_datasource.ds.id
+		}
+	]
+}
+
+resource "raito_role_assignment" "user" {
+	role = "Owner"
+	resource_type = "access_provider"
+	resource_id = raito_grant.test.id
+	user = raito_user.u1.id
+}
+
+resource "raito_role_assignment" "group" {
+	role = "Owner"
+	resource_type = "access_provider"
+	resource_id = raito_grant.test.id
+	group = raito_group.g1.id
+}
+`, testId),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("raito_role_assignment.user", "role", "Owner"),
+						resource.TestCheckResourceAttrPair("raito_role_assignment.user", "resource_id", "raito_grant.test", "id"),
+						resource.TestCheckResourceAttrPair("raito_role_assignment.user", "user", "raito_user.u1", "id"),
+						resource.TestCheckNoResourceAttr("raito_role_assignment.user", "group"),
+						resource.TestCheckResourceAttrPair("raito_role_assignment.group", "group", "raito_group.g1", "id"),
+						resource.TestCheckNoResourceAttr("raito_role_assignment.group", "user"),
+					),
+				},
+				{
+					ResourceName:      "raito_role_assignment.user",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName:      "raito_role_assignment.group",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
+	t.Run("same resource and role", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest: false,
+			PreCheck: func() {
+				AccProviderPreCheck(t)
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_0_0),
+			},
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					// All role assignments are created in parallel. The plan after the apply is only empty if none of them were overwritten.
+					Config: providerConfig + fmt.Sprintf(`
+data "raito_datasource" "ds" {
+	name = "Snowflake"
+}
+
+resource "raito_user" "users" {
+	count = 5
+
+	name = "ra-tfTestUser-%[1]s-${count.index}"
+	email = "ra-test-user-%[1]s-${count.index}@raito.io"
+	raito_user = true
+}
+
+resource "raito_grant" "test" {
+	name        = "ra-tfTestGrantParallel-%[1]s"
+	description = "parallel role assignment test"
+	data_source = [
+		{
+			data_source = data.raito_datasource.ds.id
+		}
+	]
+}
+
+resource "raito_role_assignment" "users" {
+	count = 5
+
+	role = "Owner"
+	resource_type = "access_provider"
+	resource_id = raito_grant.test.id
+	user = raito_user.users[count.index].id
+}
+`, testId),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPair("raito_role_assignment.users.0", "user", "raito_user.users.0", "id"),
+						resource.TestCheckResourceAttrPair("raito_role_assignment.users.1", "user", "raito_user.users.1", "id"),
+						resource.TestCheckResourceAttrPair("raito_role_assignment.users.2", "user", "raito_user.users.2", "id"),
+						resource.TestCheckResourceAttrPair("raito_role_assignment.users.3", "user", "raito_user.users.3", "id"),
+						resource.TestCheckResourceAttrPair("raito_role_assignment.users.4", "user", "raito_user.users.4", "id"),
+					),
+				},
+			},
+		})
+	})
+}
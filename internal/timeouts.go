@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	datasourceTimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	resourceTimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// defaultTimeout is used for every operation for which no timeout is configured.
+const defaultTimeout = 20 * time.Minute
+
+func resourceTimeoutsBlock(ctx context.Context) resourceSchema.Block {
+	return resourceTimeouts.Block(ctx, resourceTimeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+func dataSourceTimeoutsBlock(ctx context.Context) datasourceSchema.Block {
+	return datasourceTimeouts.Block(ctx)
+}
+
+// withTimeout derives a context that is cancelled after the given timeout.
+// The returned function must be deferred. It releases the context and adds a clear diagnostic if the operation exceeded its timeout.
+func withTimeout(ctx context.Context, operation string, timeout time.Duration, diagnostics *diag.Diagnostics) (context.Context, func()) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+
+	return timeoutCtx, func() {
+		if errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) && !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			diagnostics.AddError(
+				fmt.Sprintf("Timeout during %s", operation),
+				fmt.Sprintf("The %s operation did not complete within %s. Increase the %q value in the timeouts block if the operation needs more time.", operation, timeout, operation),
+			)
+		}
+
+		cancel()
+	}
+}
@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("completed in time", func(t *testing.T) {
+		var diagnostics diag.Diagnostics
+
+		_, done := withTimeout(context.Background(), "create", time.Minute, &diagnostics)
+		done()
+
+		if diagnostics.HasError() {
+			t.Errorf("unexpected diagnostics: %v", diagnostics)
+		}
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		var diagnostics diag.Diagnostics
+
+		ctx, done := withTimeout(context.Background(), "update", time.Millisecond, &diagnostics)
+		<-ctx.Done()
+		done()
+
+		if diagnostics.ErrorsCount() != 1 {
+			t.Fatalf("expected one timeout error, got %v", diagnostics)
+		}
+
+		if detail := diagnostics.Errors()[0].Detail(); !strings.Contains(detail, `"update"`) || !strings.Contains(detail, "1ms") {
+			t.Errorf("expected timeout detail to mention the operation and timeout, got %q", detail)
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package abac_expression
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = (*AbacExpressionType)(nil)
+	_ basetypes.StringValuable                   = (*AbacExpressionValue)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*AbacExpressionValue)(nil)
+	_ xattr.ValidateableAttribute                = (*AbacExpressionValue)(nil)
+)
+
+// AbacExpressionType is the type of attributes containing the dsl representation of an abac rule.
+type AbacExpressionType struct {
+	basetypes.StringType
+}
+
+func (t AbacExpressionType) String() string {
+	return "abac_expression.AbacExpressionType"
+}
+
+func (t AbacExpressionType) ValueType(_ context.Context) attr.Value {
+	return AbacExpressionValue{}
+}
+
+func (t AbacExpressionType) Equal(o attr.Type) bool {
+	other, ok := o.(AbacExpressionType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t AbacExpressionType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return AbacExpressionValue{
+		StringValue: in,
+	}, nil
+}
+
+func (t AbacExpressionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("string value from terraform: %w", err)
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// AbacExpressionValue is the dsl representation of an abac rule, see ParseDsl.
+// Two values are semantically equal if the rules are equivalent, see BinaryExpression.Equivalent.
+type AbacExpressionValue struct {
+	basetypes.StringValue
+}
+
+func (v AbacExpressionValue) Type(_ context.Context) attr.Type {
+	return AbacExpressionType{}
+}
+
+func (v AbacExpressionValue) Equal(o attr.Value) bool {
+	other, ok := o.(AbacExpressionValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v AbacExpressionValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(AbacExpressionValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	rule, err := v.Parse()
+	if err != nil {
+		return false, diags
+	}
+
+	newRule, err := newValue.Parse()
+	if err != nil {
+		return false, diags
+	}
+
+	return rule.Equivalent(*newRule), diags
+}
+
+func (v AbacExpressionValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := v.Parse(); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid abac expression", fmt.Sprintf("Syntax error in abac expression at %s", err.Error()))
+	}
+}
+
+// Parse parses the dsl representation of the abac rule.
+func (v AbacExpressionValue) Parse() (*BinaryExpression, error) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, fmt.Errorf("abac expression value is null or unknown")
+	}
+
+	return ParseDsl(v.ValueString())
+}
+
+func NewAbacExpressionNull() AbacExpressionValue {
+	return AbacExpressionValue{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+func NewAbacExpressionValue(value string) AbacExpressionValue {
+	return AbacExpressionValue{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
@@ -0,0 +1,198 @@
+package abac_expression
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = (*AbacRuleType)(nil)
+	_ basetypes.StringValuable                   = (*AbacRuleValue)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*AbacRuleValue)(nil)
+	_ xattr.ValidateableAttribute                = (*AbacRuleValue)(nil)
+)
+
+// AbacRuleType is the type of attributes containing the json representation of an abac rule.
+type AbacRuleType struct {
+	basetypes.StringType
+}
+
+func (t AbacRuleType) String() string {
+	return "abac_expression.AbacRuleType"
+}
+
+func (t AbacRuleType) ValueType(_ context.Context) attr.Value {
+	return AbacRuleValue{}
+}
+
+func (t AbacRuleType) Equal(o attr.Type) bool {
+	other, ok := o.(AbacRuleType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t AbacRuleType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return AbacRuleValue{
+		StringValue: in,
+	}, nil
+}
+
+func (t AbacRuleType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("string value from terraform: %w", err)
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// AbacRuleValue is the json representation of an abac rule.
+// Two values are semantically equal if the rules are equivalent, regardless of the order of the operands of aggregators and of the values of string lists.
+type AbacRuleValue struct {
+	basetypes.StringValue
+}
+
+func (v AbacRuleValue) Type(_ context.Context) attr.Type {
+	return AbacRuleType{}
+}
+
+func (v AbacRuleValue) Equal(o attr.Value) bool {
+	other, ok := o.(AbacRuleValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v AbacRuleValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(AbacRuleValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	var rule, newRule BinaryExpression
+
+	diags.Append(v.Unmarshal(&rule)...)
+	diags.Append(newValue.Unmarshal(&newRule)...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return rule.Equivalent(newRule), diags
+}
+
+func (v AbacRuleValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if ok := json.Valid([]byte(v.ValueString())); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+	}
+}
+
+// Unmarshal parses the abac rule into the target, which is typically a *BinaryExpression.
+func (v AbacRuleValue) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError("Abac Rule Unmarshal Error", "abac rule value is null")
+
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError("Abac Rule Unmarshal Error", "abac rule value is unknown")
+
+		return diags
+	}
+
+	if err := json.Unmarshal([]byte(v.ValueString()), target); err != nil {
+		diags.AddError("Abac Rule Unmarshal Error", err.Error())
+	}
+
+	return diags
+}
+
+func NewAbacRuleNull() AbacRuleValue {
+	return AbacRuleValue{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+func NewAbacRuleUnknown() AbacRuleValue {
+	return AbacRuleValue{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+func NewAbacRuleValue(value string) AbacRuleValue {
+	return AbacRuleValue{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+func NewAbacRulePointerValue(value *string) AbacRuleValue {
+	return AbacRuleValue{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
+
+// NewAbacRuleExpressionValue returns the json representation of the abac rule as value.
+// Object keys are sorted, as in the output of the Terraform jsonencode function.
+func NewAbacRuleExpressionValue(expression *BinaryExpression) (AbacRuleValue, error) {
+	ruleJson, err := json.Marshal(expression)
+	if err != nil {
+		return NewAbacRuleNull(), fmt.Errorf("marshal abac rule: %w", err)
+	}
+
+	var rule any
+	if err = json.Unmarshal(ruleJson, &rule); err != nil {
+		return NewAbacRuleNull(), fmt.Errorf("unmarshal abac rule: %w", err)
+	}
+
+	// Maps are marshalled with sorted keys.
+	ruleJson, err = json.Marshal(rule)
+	if err != nil {
+		return NewAbacRuleNull(), fmt.Errorf("marshal abac rule: %w", err)
+	}
+
+	return NewAbacRuleValue(string(ruleJson)), nil
+}
@@ -0,0 +1,46 @@
+package abac_expression
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAbacRuleValue_StringSemanticEquals(t *testing.T) {
+	rule := NewAbacRuleValue(`{"aggregator":{"operator":"Or","operands":[
+		{"aggregator":{"operator":"And","operands":[
+			{"comparison":{"operator":"HasTag","leftOperand":"a","rightOperand":{"literal":{"string":"x"}}}},
+			{"comparison":{"operator":"PropertyIn","leftOperand":"type","rightOperand":{"literal":{"stringList":["table","view"]}}}}
+		]}},
+		{"literal":true}
+	]}}`)
+
+	tests := []struct {
+		name     string
+		newValue string
+		expected bool
+	}{
+		{
+			name:     "reordered operands and string list",
+			newValue: `{"aggregator":{"operands":[{"literal":true},{"aggregator":{"operands":[{"comparison":{"leftOperand":"type","operator":"PropertyIn","rightOperand":{"literal":{"stringList":["view","table"]}}}},{"comparison":{"leftOperand":"a","operator":"HasTag","rightOperand":{"literal":{"string":"x"}}}}],"operator":"And"}}],"operator":"Or"}}`,
+			expected: true,
+		},
+		{
+			name:     "different value",
+			newValue: `{"aggregator":{"operands":[{"literal":true},{"aggregator":{"operands":[{"comparison":{"leftOperand":"type","operator":"PropertyIn","rightOperand":{"literal":{"stringList":["view"]}}}},{"comparison":{"leftOperand":"a","operator":"HasTag","rightOperand":{"literal":{"string":"x"}}}}],"operator":"And"}}],"operator":"Or"}}`,
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			equal, diagnostics := rule.StringSemanticEquals(context.Background(), NewAbacRuleValue(test.newValue))
+			if diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", diagnostics)
+			}
+
+			if equal != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, equal)
+			}
+		})
+	}
+}
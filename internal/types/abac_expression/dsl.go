@@ -0,0 +1,527 @@
+package abac_expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The abac dsl is a human-readable representation of abac rules, e.g.
+//
+//	has_tag("department", "Finance") and not contains_tag("pii", "x") or property_in("owner", ["a", "b"])
+//
+// Grammar (not binds stronger than and, which binds stronger than or):
+//
+//	expression := and_expr { "or" and_expr }
+//	and_expr   := unary { "and" unary }
+//	unary      := "not" unary | "(" expression ")" | "true" | "false" | comparison
+//	comparison := function "(" string "," ( value | "[" [ string { "," string } ] "]" ) ")"
+//	function   := "has_tag" | "contains_tag" | "property_equals" | "property_in"
+//	value      := string | "user" "." identifier | "user" "[" string "]"
+//
+// Strings are double-quoted and support the escape sequences of Go string literals.
+// A value starting with user refers to an attribute of the user, e.g. has_tag("department", user.department).
+
+const (
+	dslOr  = "or"
+	dslAnd = "and"
+	dslNot = "not"
+
+	dslUser = "user"
+)
+
+var dslFunctions = map[string]AbacOperator{
+	"has_tag":         AbacOperatorHasTag,
+	"contains_tag":    AbacOperatorContainsTag,
+	"property_equals": AbacOperatorPropertyEquals,
+	"property_in":     AbacOperatorPropertyIn,
+}
+
+// SyntaxError is an error in an abac dsl expression at the given position.
+type SyntaxError struct {
+	// Line and Column (in characters) are 1-based.
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message)
+}
+
+// ParseDsl parses an abac dsl expression.
+func ParseDsl(input string) (*BinaryExpression, error) {
+	p := dslParser{lexer: dslLexer{input: input, line: 1, column: 1}}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	expression, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.token.kind != dslTokenEOF {
+		return nil, p.errorf("unexpected %s, expected and, or or the end of the expression", p.token)
+	}
+
+	return expression, nil
+}
+
+// ToDsl returns the abac dsl representation of the rule.
+func (b BinaryExpression) ToDsl() string {
+	return b.toDsl(dslPrecedenceOr)
+}
+
+type dslPrecedence int
+
+const (
+	dslPrecedenceOr dslPrecedence = iota
+	dslPrecedenceAnd
+	dslPrecedenceNot
+)
+
+func (b BinaryExpression) toDsl(parent dslPrecedence) string {
+	switch {
+	case b.Literal != nil:
+		return strconv.FormatBool(*b.Literal)
+	case b.Comparison != nil:
+		return b.Comparison.toDsl()
+	case b.Aggregator != nil:
+		return b.Aggregator.toDsl(parent)
+	case b.UnaryExpression != nil:
+		return dslNot + " " + b.UnaryExpression.Operand.toDsl(dslPrecedenceNot)
+	default:
+		return "<invalid>"
+	}
+}
+
+func (a Aggregator) toDsl(parent dslPrecedence) string {
+	operator, precedence := dslOr, dslPrecedenceOr
+	if a.Operator == AggregatorOperatorAnd {
+		operator, precedence = dslAnd, dslPrecedenceAnd
+	}
+
+	switch len(a.Operands) {
+	case 0:
+		// An empty And always matches, an empty Or never matches.
+		return strconv.FormatBool(a.Operator == AggregatorOperatorAnd)
+	case 1:
+		return a.Operands[0].toDsl(parent)
+	}
+
+	operands := make([]string, 0, len(a.Operands))
+	for _, operand := range a.Operands {
+		operands = append(operands, operand.toDsl(precedence))
+	}
+
+	result := strings.Join(operands, " "+operator+" ")
+	if parent > precedence {
+		return "(" + result + ")"
+	}
+
+	return result
+}
+
+func (c AbacComparison) toDsl() string {
+	function := strings.ToLower(c.Operator.String())
+
+	for name, operator := range dslFunctions {
+		if operator == c.Operator {
+			function = name
+		}
+	}
+
+	var value string
+
+	if reference := c.RightOperand.Reference; reference != nil {
+		value = reference.toDsl()
+	} else if literal := c.RightOperand.Literal; literal != nil && literal.StringList != nil {
+		values := make([]string, 0, len(literal.StringList))
+		for _, v := range literal.StringList {
+			values = append(values, strconv.Quote(v))
+		}
+
+		value = "[" + strings.Join(values, ", ") + "]"
+	} else if literal != nil && literal.String != nil {
+		value = strconv.Quote(*literal.String)
+	}
+
+	return fmt.Sprintf("%s(%s, %s)", function, strconv.Quote(c.LeftOperand), value)
+}
+
+func (r Reference) toDsl() string {
+	if isDslIdentifier(r.UserAttribute) {
+		return dslUser + "." + r.UserAttribute
+	}
+
+	return fmt.Sprintf("%s[%s]", dslUser, strconv.Quote(r.UserAttribute))
+}
+
+func isDslIdentifier(value string) bool {
+	for i, r := range value {
+		if !isDslIdentifierRune(r, i == 0) {
+			return false
+		}
+	}
+
+	return value != ""
+}
+
+func isDslIdentifierRune(r rune, first bool) bool {
+	return r == '_' || unicode.IsLetter(r) || (!first && unicode.IsDigit(r))
+}
+
+type dslTokenKind int
+
+const (
+	dslTokenEOF dslTokenKind = iota
+	dslTokenIdentifier
+	dslTokenString
+	dslTokenSymbol
+)
+
+type dslToken struct {
+	kind   dslTokenKind
+	value  string
+	line   int
+	column int
+}
+
+func (t dslToken) String() string {
+	switch t.kind {
+	case dslTokenEOF:
+		return "end of expression"
+	case dslTokenString:
+		return "string " + strconv.Quote(t.value)
+	case dslTokenIdentifier, dslTokenSymbol:
+		return strconv.Quote(t.value)
+	default:
+		return t.value
+	}
+}
+
+type dslLexer struct {
+	input  string
+	offset int
+	line   int
+	column int
+}
+
+func (l *dslLexer) peek() rune {
+	r, _ := utf8.DecodeRuneInString(l.input[l.offset:])
+
+	return r
+}
+
+func (l *dslLexer) advance() rune {
+	r, size := utf8.DecodeRuneInString(l.input[l.offset:])
+	l.offset += size
+
+	if r == '\n' {
+		l.line++
+		l.column = 1
+	} else {
+		l.column++
+	}
+
+	return r
+}
+
+func (l *dslLexer) next() (dslToken, error) {
+	for l.offset < len(l.input) && unicode.IsSpace(l.peek()) {
+		l.advance()
+	}
+
+	token := dslToken{line: l.line, column: l.column}
+
+	if l.offset >= len(l.input) {
+		return token, nil
+	}
+
+	r := l.peek()
+
+	switch {
+	case isDslIdentifierRune(r, true):
+		start := l.offset
+		for l.offset < len(l.input) && isDslIdentifierRune(l.peek(), false) {
+			l.advance()
+		}
+
+		token.kind, token.value = dslTokenIdentifier, l.input[start:l.offset]
+	case r == '"':
+		value, err := l.string()
+		if err != nil {
+			return token, err
+		}
+
+		token.kind, token.value = dslTokenString, value
+	case strings.ContainsRune("()[],.", r):
+		l.advance()
+
+		token.kind, token.value = dslTokenSymbol, string(r)
+	default:
+		return token, &SyntaxError{Line: token.line, Column: token.column, Message: fmt.Sprintf("unexpected character %q", r)}
+	}
+
+	return token, nil
+}
+
+// string scans a double-quoted string and returns its unquoted value.
+func (l *dslLexer) string() (string, error) {
+	line, column, start := l.line, l.column, l.offset
+
+	l.advance()
+
+	for l.offset < len(l.input) {
+		switch l.advance() {
+		case '\\':
+			if l.offset < len(l.input) {
+				l.advance()
+			}
+		case '\n':
+			return "", &SyntaxError{Line: line, Column: column, Message: "unterminated string"}
+		case '"':
+			value, err := strconv.Unquote(l.input[start:l.offset])
+			if err != nil {
+				return "", &SyntaxError{Line: line, Column: column, Message: "invalid escape sequence in string"}
+			}
+
+			return value, nil
+		}
+	}
+
+	return "", &SyntaxError{Line: line, Column: column, Message: "unterminated string"}
+}
+
+type dslParser struct {
+	lexer dslLexer
+	token dslToken
+}
+
+func (p *dslParser) next() error {
+	token, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+
+	p.token = token
+
+	return nil
+}
+
+func (p *dslParser) errorf(format string, args ...any) error {
+	return &SyntaxError{Line: p.token.line, Column: p.token.column, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *dslParser) isIdentifier(value string) bool {
+	return p.token.kind == dslTokenIdentifier && p.token.value == value
+}
+
+func (p *dslParser) expectSymbol(symbol string) error {
+	if p.token.kind != dslTokenSymbol || p.token.value != symbol {
+		return p.errorf("unexpected %s, expected %q", p.token, symbol)
+	}
+
+	return p.next()
+}
+
+func (p *dslParser) parseOr() (*BinaryExpression, error) {
+	return p.parseAggregator(AggregatorOperatorOr, dslOr, p.parseAnd)
+}
+
+func (p *dslParser) parseAnd() (*BinaryExpression, error) {
+	return p.parseAggregator(AggregatorOperatorAnd, dslAnd, p.parseUnary)
+}
+
+func (p *dslParser) parseAggregator(operator AggregatorOperator, keyword string, parseOperand func() (*BinaryExpression, error)) (*BinaryExpression, error) {
+	operand, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isIdentifier(keyword) {
+		return operand, nil
+	}
+
+	operands := []BinaryExpression{*operand}
+
+	for p.isIdentifier(keyword) {
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+
+		operand, err = parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		operands = append(operands, *operand)
+	}
+
+	return &BinaryExpression{Aggregator: &Aggregator{Operator: operator, Operands: operands}}, nil
+}
+
+func (p *dslParser) parseUnary() (*BinaryExpression, error) {
+	switch {
+	case p.isIdentifier(dslNot):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &BinaryExpression{UnaryExpression: &UnaryExpression{Operator: UnaryOperatorNot, Operand: *operand}}, nil
+	case p.token.kind == dslTokenSymbol && p.token.value == "(":
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		expression, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		return expression, p.expectSymbol(")")
+	case p.isIdentifier("true"), p.isIdentifier("false"):
+		literal := p.token.value == "true"
+
+		return &BinaryExpression{Literal: &literal}, p.next()
+	case p.token.kind == dslTokenIdentifier:
+		return p.parseComparison()
+	default:
+		return nil, p.errorf("unexpected %s, expected a comparison, not, true, false or \"(\"", p.token)
+	}
+}
+
+func (p *dslParser) parseComparison() (*BinaryExpression, error) {
+	operator, found := dslFunctions[p.token.value]
+	if !found {
+		return nil, p.errorf("unknown function %q, expected one of has_tag, contains_tag, property_equals or property_in", p.token.value)
+	}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	if err := p.expectSymbol("("); err != nil {
+		return nil, err
+	}
+
+	leftOperand, err := p.parseString()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.expectSymbol(","); err != nil {
+		return nil, err
+	}
+
+	var rightOperand Operand
+
+	if operator == AbacOperatorPropertyIn {
+		var values []string
+		values, err = p.parseStringList()
+		rightOperand.Literal = &Literal{StringList: values}
+	} else {
+		rightOperand, err = p.parseValue()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.expectSymbol(")"); err != nil {
+		return nil, err
+	}
+
+	return &BinaryExpression{Comparison: &AbacComparison{Operator: operator, LeftOperand: leftOperand, RightOperand: rightOperand}}, nil
+}
+
+// parseValue parses a string literal or a reference to a user attribute.
+func (p *dslParser) parseValue() (Operand, error) {
+	switch {
+	case p.token.kind == dslTokenString:
+		value, err := p.parseString()
+
+		return Operand{Literal: &Literal{String: &value}}, err
+	case p.isIdentifier(dslUser):
+		if err := p.next(); err != nil {
+			return Operand{}, err
+		}
+
+		attribute, err := p.parseUserAttribute()
+
+		return Operand{Reference: &Reference{UserAttribute: attribute}}, err
+	default:
+		return Operand{}, p.errorf("unexpected %s, expected a string or user attribute", p.token)
+	}
+}
+
+func (p *dslParser) parseUserAttribute() (string, error) {
+	if p.token.kind == dslTokenSymbol && p.token.value == "[" {
+		if err := p.next(); err != nil {
+			return "", err
+		}
+
+		attribute, err := p.parseString()
+		if err != nil {
+			return "", err
+		}
+
+		return attribute, p.expectSymbol("]")
+	}
+
+	if err := p.expectSymbol("."); err != nil {
+		return "", err
+	}
+
+	if p.token.kind != dslTokenIdentifier {
+		return "", p.errorf("unexpected %s, expected the name of a user attribute", p.token)
+	}
+
+	attribute := p.token.value
+
+	return attribute, p.next()
+}
+
+func (p *dslParser) parseString() (string, error) {
+	if p.token.kind != dslTokenString {
+		return "", p.errorf("unexpected %s, expected a string", p.token)
+	}
+
+	value := p.token.value
+
+	return value, p.next()
+}
+
+func (p *dslParser) parseStringList() ([]string, error) {
+	if err := p.expectSymbol("["); err != nil {
+		return nil, err
+	}
+
+	values := []string{}
+
+	for p.token.kind != dslTokenSymbol || p.token.value != "]" {
+		if len(values) > 0 {
+			if err := p.expectSymbol(","); err != nil {
+				return nil, err
+			}
+		}
+
+		value, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, p.next()
+}
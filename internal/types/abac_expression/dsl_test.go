@@ -0,0 +1,111 @@
+package abac_expression
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseDsl(t *testing.T) {
+	expression, err := ParseDsl(`has_tag("department", "Finance") and not contains_tag("pii", "x") or property_in("owner", ["a","b"])`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := testAggregator("Or",
+		testAggregator("And",
+			`{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Finance"}}}}`,
+			testNot(`{"comparison":{"operator":"ContainsTag","leftOperand":"pii","rightOperand":{"literal":{"string":"x"}}}}`),
+		),
+		`{"comparison":{"operator":"PropertyIn","leftOperand":"owner","rightOperand":{"literal":{"stringList":["a","b"]}}}}`,
+	)
+
+	expressionJson, err := json.Marshal(expression)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedJson, err := json.Marshal(testRule(t, expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(expressionJson) != string(expectedJson) {
+		t.Errorf("unexpected expression\n got: %s\nwant: %s", expressionJson, expectedJson)
+	}
+}
+
+func TestDsl_RoundTrip(t *testing.T) {
+	tests := []string{
+		`true`,
+		`not false`,
+		`has_tag("department", "Finance")`,
+		`has_tag("department", "Finance") and not contains_tag("pii", "x") or property_in("owner", ["a", "b"])`,
+		`(has_tag("a", "1") or has_tag("b", "2")) and property_equals("type", "table")`,
+		`not (has_tag("a", "1") and has_tag("b", "2")) or not not has_tag("c", "3")`,
+		`property_in("type", []) and has_tag("quote\"d", "new\nline")`,
+		`has_tag("department", user.department) and property_equals("cost-center", user["cost-center"])`,
+	}
+
+	for _, test := range tests {
+		t.Run(test, func(t *testing.T) {
+			expression, err := ParseDsl(test)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if printed := expression.ToDsl(); printed != test {
+				t.Errorf("unexpected dsl\n got: %s\nwant: %s", printed, test)
+			}
+		})
+	}
+}
+
+func TestDsl_RoundTripFromJson(t *testing.T) {
+	rule := testRule(t, testAggregator("Or",
+		testAggregator("And", testTag("a"), testNot(testAggregator("Or", testTag("b"), testTag("c")))),
+		testAggregator("And", testTag("d")),
+	))
+
+	expected := `has_tag("a", "x") and not (has_tag("b", "x") or has_tag("c", "x")) or has_tag("d", "x")`
+	if printed := rule.ToDsl(); printed != expected {
+		t.Fatalf("unexpected dsl\n got: %s\nwant: %s", printed, expected)
+	}
+
+	parsed, err := ParseDsl(rule.ToDsl())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !parsed.Equivalent(rule) {
+		t.Errorf("parsed rule is not equivalent to the original rule")
+	}
+}
+
+func TestParseDsl_SyntaxErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: ``, expected: `1:1: unexpected end of expression, expected a comparison, not, true, false or "("`},
+		{input: `has_tag("a", "b") and`, expected: `1:22: unexpected end of expression, expected a comparison, not, true, false or "("`},
+		{input: `has_tag("a", "b") has_tag("c", "d")`, expected: `1:19: unexpected "has_tag", expected and, or or the end of the expression`},
+		{input: "has_tag(\"a\", \"b\") and\n  is_tag(\"c\", \"d\")", expected: `2:3: unknown function "is_tag", expected one of has_tag, contains_tag, property_equals or property_in`},
+		{input: `property_in("a", "b")`, expected: `1:18: unexpected string "b", expected "["`},
+		{input: `has_tag("a", ["b"])`, expected: `1:14: unexpected "[", expected a string or user attribute`},
+		{input: `has_tag("a", user)`, expected: `1:18: unexpected ")", expected "."`},
+		{input: `has_tag("a", user."b")`, expected: `1:19: unexpected string "b", expected the name of a user attribute`},
+		{input: `has_tag("a, "b")`, expected: `1:14: unexpected "b", expected ","`},
+		{input: `(has_tag("a", "b")`, expected: `1:19: unexpected end of expression, expected ")"`},
+		{input: `has_tag("a", "b) && true`, expected: `1:14: unterminated string`},
+		{input: `has_tag("a", "b") && true`, expected: `1:19: unexpected character '&'`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			_, err := ParseDsl(test.input)
+			if err == nil || err.Error() != test.expected {
+				t.Errorf("unexpected error\n got: %v\nwant: %s", err, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package abac_expression
+
+import (
+	"slices"
+	"strings"
+)
+
+// Evaluate evaluates the abac rule for an object with the given tags and properties.
+// The tags map a tag key to all values of that key on the object.
+//
+// The comparison operators are evaluated as follows:
+//   - HasTag: one of the values of the tag equals the right operand.
+//   - ContainsTag: one of the values of the tag contains the right operand.
+//   - PropertyEquals: the property equals the right operand.
+//   - PropertyIn: the property equals one of the values of the right operand.
+//
+// Comparisons with a reference to a user attribute never match, use EvaluateForUser to evaluate them.
+// An error is returned if the rule is structurally invalid, see Validate.
+func (b *BinaryExpression) Evaluate(tags map[string][]string, properties map[string]string) (bool, error) {
+	return b.EvaluateForUser(tags, properties, nil)
+}
+
+// EvaluateForUser evaluates the abac rule, like Evaluate, for a user with the given attributes.
+// Reference operands are resolved to the attributes of the user. A comparison with a reference to a missing attribute never matches.
+func (b *BinaryExpression) EvaluateForUser(tags map[string][]string, properties map[string]string, userAttributes map[string]string) (bool, error) {
+	if errs := b.Validate(); errs != nil {
+		return false, errs
+	}
+
+	return b.evaluate(&evaluation{tags: tags, properties: properties, userAttributes: userAttributes}), nil
+}
+
+// evaluation contains the object and user for which a rule is evaluated.
+type evaluation struct {
+	tags           map[string][]string
+	properties     map[string]string
+	userAttributes map[string]string
+}
+
+func (b *BinaryExpression) evaluate(e *evaluation) bool {
+	switch {
+	case b.Literal != nil:
+		return *b.Literal
+	case b.Comparison != nil:
+		return b.Comparison.evaluate(e)
+	case b.Aggregator != nil:
+		return b.Aggregator.evaluate(e)
+	case b.UnaryExpression != nil:
+		// Not is the only unary operator.
+		return !b.UnaryExpression.Operand.evaluate(e)
+	default:
+		return false
+	}
+}
+
+func (a *Aggregator) evaluate(e *evaluation) bool {
+	for i := range a.Operands {
+		result := a.Operands[i].evaluate(e)
+
+		if a.Operator == AggregatorOperatorOr && result {
+			return true
+		} else if a.Operator == AggregatorOperatorAnd && !result {
+			return false
+		}
+	}
+
+	return a.Operator == AggregatorOperatorAnd
+}
+
+func (c *AbacComparison) evaluate(e *evaluation) bool {
+	switch c.Operator {
+	case AbacOperatorHasTag:
+		value, found := c.RightOperand.value(e)
+
+		return found && slices.Contains(e.tags[c.LeftOperand], value)
+	case AbacOperatorContainsTag:
+		value, found := c.RightOperand.value(e)
+
+		return found && slices.ContainsFunc(e.tags[c.LeftOperand], func(tagValue string) bool {
+			return strings.Contains(tagValue, value)
+		})
+	case AbacOperatorPropertyEquals:
+		value, found := c.RightOperand.value(e)
+		property, propertyFound := e.properties[c.LeftOperand]
+
+		return found && propertyFound && property == value
+	case AbacOperatorPropertyIn:
+		property, found := e.properties[c.LeftOperand]
+
+		return found && slices.Contains(c.RightOperand.Literal.StringList, property)
+	default:
+		// Unsupported operators are rejected by Validate.
+		return false
+	}
+}
+
+// value returns the string value of the operand. False is returned if the operand refers to a missing user attribute.
+func (o *Operand) value(e *evaluation) (string, bool) {
+	if o.Reference != nil {
+		value, found := e.userAttributes[o.Reference.UserAttribute]
+
+		return value, found
+	}
+
+	return *o.Literal.String, true
+}
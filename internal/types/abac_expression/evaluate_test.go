@@ -0,0 +1,138 @@
+package abac_expression
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBinaryExpression_Evaluate(t *testing.T) {
+	comparison := func(operator string, leftOperand string, literal string) string {
+		return fmt.Sprintf(`{"comparison":{"operator":%q,"leftOperand":%q,"rightOperand":{"literal":%s}}}`, operator, leftOperand, literal)
+	}
+
+	financeWithoutPii := testAggregator("And", comparison("HasTag", "department", `{"string":"Finance"}`), testNot(comparison("ContainsTag", "classification", `{"string":"pii"}`)))
+
+	tests := []struct {
+		name       string
+		rule       string
+		tags       map[string][]string
+		properties map[string]string
+		expected   bool
+	}{
+		{
+			name:     "literal",
+			rule:     `{"literal":true}`,
+			expected: true,
+		},
+		{
+			name:     "has tag",
+			rule:     financeWithoutPii,
+			tags:     map[string][]string{"department": {"Sales", "Finance"}},
+			expected: true,
+		},
+		{
+			name:     "has tag with other value",
+			rule:     financeWithoutPii,
+			tags:     map[string][]string{"department": {"Sales"}},
+			expected: false,
+		},
+		{
+			name:     "contains tag",
+			rule:     financeWithoutPii,
+			tags:     map[string][]string{"department": {"Finance"}, "classification": {"pii-restricted"}},
+			expected: false,
+		},
+		{
+			name:       "property equals",
+			rule:       comparison("PropertyEquals", "owner", `{"string":"finance-team"}`),
+			properties: map[string]string{"owner": "finance-team"},
+			expected:   true,
+		},
+		{
+			name:     "property equals without property",
+			rule:     testNot(comparison("PropertyEquals", "owner", `{"string":""}`)),
+			expected: true,
+		},
+		{
+			name:       "property in",
+			rule:       testAggregator("Or", comparison("PropertyIn", "type", `{"stringList":["table","view"]}`), comparison("HasTag", "public", `{"string":"true"}`)),
+			properties: map[string]string{"type": "view"},
+			expected:   true,
+		},
+		{
+			name:       "property not in",
+			rule:       testAggregator("Or", comparison("PropertyIn", "type", `{"stringList":["table","view"]}`), comparison("HasTag", "public", `{"string":"true"}`)),
+			properties: map[string]string{"type": "schema"},
+			expected:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule := testRule(t, test.rule)
+
+			result, err := rule.Evaluate(test.tags, test.properties)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, result)
+			}
+
+			normalized, err := rule.Normalize()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if normalizedResult, _ := normalized.Evaluate(test.tags, test.properties); normalizedResult != result {
+				t.Errorf("normalized rule evaluates to %t", normalizedResult)
+			}
+		})
+	}
+}
+
+func TestBinaryExpression_EvaluateInvalidRule(t *testing.T) {
+	rule := testRule(t, `{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{}}}`)
+
+	if _, err := rule.Evaluate(nil, nil); err == nil || err.Error() != "comparison.rightOperand: exactly one of literal or reference must be set, got 0" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestBinaryExpression_EvaluateForUser(t *testing.T) {
+	rule := testRule(t, testAggregator("And",
+		`{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"reference":{"userAttribute":"department"}}}}`,
+		`{"comparison":{"operator":"PropertyEquals","leftOperand":"country","rightOperand":{"reference":{"userAttribute":"country"}}}}`,
+	))
+
+	tags := map[string][]string{"department": {"Finance"}}
+	properties := map[string]string{"country": "BE"}
+
+	tests := []struct {
+		name           string
+		userAttributes map[string]string
+		expected       bool
+	}{
+		{name: "matching user", userAttributes: map[string]string{"department": "Finance", "country": "BE"}, expected: true},
+		{name: "other department", userAttributes: map[string]string{"department": "Sales", "country": "BE"}, expected: false},
+		{name: "missing attribute", userAttributes: map[string]string{"department": "Finance"}, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := rule.EvaluateForUser(tags, properties, test.userAttributes)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, result)
+			}
+		})
+	}
+
+	if result, _ := rule.Evaluate(tags, properties); result {
+		t.Errorf("expected references to never match without user")
+	}
+}
@@ -0,0 +1,129 @@
+package abac_expression
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	raitoType "github.com/raito-io/sdk-go/types"
+)
+
+// ErrRuleMissing is returned by FromServer if Raito Cloud returned neither the structured nor the json representation of an abac rule.
+var ErrRuleMissing = errors.New("abac rule is missing")
+
+// FromServer builds the abac rule as returned by Raito Cloud. The structured rule is used if available.
+// The json representation is only used as fallback.
+func FromServer(rule *raitoType.AbacComparisonExpression, ruleJson *string) (*BinaryExpression, error) {
+	if rule != nil {
+		return FromGql(rule)
+	}
+
+	if ruleJson != nil {
+		return FromRuleJson(*ruleJson)
+	}
+
+	return nil, ErrRuleMissing
+}
+
+// FromRuleJson builds the abac rule from its json representation.
+func FromRuleJson(ruleJson string) (*BinaryExpression, error) {
+	var expression BinaryExpression
+	if err := json.Unmarshal([]byte(ruleJson), &expression); err != nil {
+		return nil, fmt.Errorf("unmarshal abac rule json: %w", err)
+	}
+
+	return &expression, nil
+}
+
+// FromGql builds the abac rule from the structured rule returned by Raito Cloud. It is the inverse of BinaryExpression.ToGqlInput.
+func FromGql(rule *raitoType.AbacComparisonExpression) (*BinaryExpression, error) {
+	var err error
+
+	result := BinaryExpression{Literal: rule.Literal}
+
+	if rule.Comparison != nil {
+		result.Comparison, err = comparisonFromGql(rule.Comparison)
+		if err != nil {
+			return nil, fmt.Errorf("comparison from gql: %w", err)
+		}
+	}
+
+	if rule.Aggregator != nil {
+		result.Aggregator, err = aggregatorFromGql(rule.Aggregator)
+		if err != nil {
+			return nil, fmt.Errorf("aggregator from gql: %w", err)
+		}
+	}
+
+	if rule.UnaryExpression != nil {
+		result.UnaryExpression, err = unaryExpressionFromGql(rule.UnaryExpression)
+		if err != nil {
+			return nil, fmt.Errorf("unaryExpression from gql: %w", err)
+		}
+	}
+
+	return &result, nil
+}
+
+func comparisonFromGql(comparison *raitoType.AbacComparisonExpressionComparison) (*AbacComparison, error) {
+	operator, err := AbacOperatorString(string(comparison.Operator))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported comparison operator %q: %w", comparison.Operator, err)
+	}
+
+	var rightOperand Operand
+
+	if comparison.RightOperand.Literal != nil {
+		rightOperand.Literal = &Literal{
+			Bool:       comparison.RightOperand.Literal.Bool,
+			String:     comparison.RightOperand.Literal.String,
+			StringList: comparison.RightOperand.Literal.StringList,
+		}
+	}
+
+	if comparison.RightOperand.Reference != nil {
+		rightOperand.Reference = &Reference{
+			UserAttribute: comparison.RightOperand.Reference.UserAttribute,
+		}
+	}
+
+	return &AbacComparison{
+		Operator:     operator,
+		LeftOperand:  comparison.LeftOperand,
+		RightOperand: rightOperand,
+	}, nil
+}
+
+func aggregatorFromGql(aggregator *raitoType.AbacComparisonExpressionAggregator) (*Aggregator, error) {
+	operator, err := AggregatorOperatorString(string(aggregator.Operator))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported aggregator operator %q: %w", aggregator.Operator, err)
+	}
+
+	operands := make([]BinaryExpression, 0, len(aggregator.Operands))
+
+	for i := range aggregator.Operands {
+		operand, err := FromGql(&aggregator.Operands[i])
+		if err != nil {
+			return nil, fmt.Errorf("operand %d: %w", i, err)
+		}
+
+		operands = append(operands, *operand)
+	}
+
+	return &Aggregator{Operator: operator, Operands: operands}, nil
+}
+
+func unaryExpressionFromGql(unaryExpression *raitoType.AbacComparisonExpressionUnaryExpression) (*UnaryExpression, error) {
+	operator, err := UnaryOperatorString(string(unaryExpression.Operator))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported unary operator %q: %w", unaryExpression.Operator, err)
+	}
+
+	operand, err := FromGql(&unaryExpression.Operand)
+	if err != nil {
+		return nil, fmt.Errorf("operand: %w", err)
+	}
+
+	return &UnaryExpression{Operator: operator, Operand: *operand}, nil
+}
@@ -0,0 +1,135 @@
+package abac_expression
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	raitoType "github.com/raito-io/sdk-go/types"
+
+	"github.com/raito-io/terraform-provider-raito/internal/utils"
+)
+
+func testGqlRule() *raitoType.AbacComparisonExpression {
+	return &raitoType.AbacComparisonExpression{
+		Aggregator: &raitoType.AbacComparisonExpressionAggregator{
+			Operator: "Or",
+			Operands: []raitoType.AbacComparisonExpression{
+				{
+					Aggregator: &raitoType.AbacComparisonExpressionAggregator{
+						Operator: "And",
+						Operands: []raitoType.AbacComparisonExpression{
+							{
+								Comparison: &raitoType.AbacComparisonExpressionComparison{
+									Operator:    "HasTag",
+									LeftOperand: "department",
+									RightOperand: raitoType.AbacComparisonExpressionOperand{
+										Reference: &raitoType.AbacComparisonExpressionReference{UserAttribute: "department"},
+									},
+								},
+							},
+							{
+								UnaryExpression: &raitoType.AbacComparisonExpressionUnaryExpression{
+									Operator: "Not",
+									Operand: raitoType.AbacComparisonExpression{
+										Comparison: &raitoType.AbacComparisonExpressionComparison{
+											Operator:    "PropertyIn",
+											LeftOperand: "type",
+											RightOperand: raitoType.AbacComparisonExpressionOperand{
+												Literal: &raitoType.AbacComparisonExpressionLiteral{StringList: []string{"view"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{Literal: utils.Ptr(true)},
+			},
+		},
+	}
+}
+
+func TestFromGql(t *testing.T) {
+	want := testRule(t, testAggregator("Or",
+		testAggregator("And",
+			`{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"reference":{"userAttribute":"department"}}}}`,
+			testNot(`{"comparison":{"operator":"PropertyIn","leftOperand":"type","rightOperand":{"literal":{"stringList":["view"]}}}}`),
+		),
+		`{"literal":true}`,
+	))
+
+	result, err := FromGql(testGqlRule())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.Equal(want) {
+		t.Errorf("unexpected rule\n got: %s\nwant: %s", result.sortKey(), want.sortKey())
+	}
+}
+
+func TestFromGql_UnsupportedOperator(t *testing.T) {
+	rule := testGqlRule()
+	rule.Aggregator.Operands[0].Aggregator.Operands[0].Comparison.Operator = "Matches"
+
+	if _, err := FromGql(rule); err == nil {
+		t.Error("expected an error for an unsupported operator")
+	}
+}
+
+func TestFromServer(t *testing.T) {
+	ruleJson := `{"aggregator":{"operator":"Or","operands":[{"literal":true}]}}`
+
+	rule, err := FromServer(nil, &ruleJson)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	value, err := NewAbacRuleExpressionValue(rule)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expected := `{"aggregator":{"operands":[{"literal":true}],"operator":"Or"}}`; value.ValueString() != expected {
+		t.Errorf("unexpected json\n got: %s\nwant: %s", value.ValueString(), expected)
+	}
+
+	// The structured rule takes precedence over the json representation.
+	rule, err = FromServer(&raitoType.AbacComparisonExpression{Literal: utils.Ptr(false)}, &ruleJson)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rule.Literal == nil || *rule.Literal {
+		t.Errorf("expected the structured rule, got %s", rule.sortKey())
+	}
+
+	if _, err = FromServer(nil, nil); !errors.Is(err, ErrRuleMissing) {
+		t.Errorf("unexpected error for missing rule: %v", err)
+	}
+}
+
+func TestReferencesInGqlInput(t *testing.T) {
+	rule, err := ParseDsl(`has_tag("department", user.department) and not contains_tag("team", user["team name"]) or property_in("type", ["view"])`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	input, err := rule.ToGqlInput()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	references := ReferencesInGqlInput(input)
+
+	expected := []string{`has_tag("department", user.department)`, `contains_tag("team", user["team name"])`}
+	if !slices.Equal(references, expected) {
+		t.Errorf("unexpected references\n got: %v\nwant: %v", references, expected)
+	}
+
+	if references = ReferencesInGqlInput(nil); references != nil {
+		t.Errorf("expected no references for a missing rule, got %v", references)
+	}
+}
@@ -2,6 +2,7 @@ package abac_expression
 
 import (
 	"fmt"
+	"strconv"
 
 	raitoType "github.com/raito-io/sdk-go/types"
 
@@ -23,7 +24,7 @@ func (b BinaryExpression) ToGqlInput() (*raitoType.AbacComparisonExpressionInput
 	var err error
 
 	if b.Comparison != nil {
-		comparison = utils.Ptr(b.Comparison.ToGqlInput())
+		comparison = b.Comparison.ToGqlInput()
 	} else if b.Aggregator != nil {
 		aggregator, err = b.Aggregator.ToGqlInput()
 		if err != nil {
@@ -60,30 +61,80 @@ type AbacComparison struct {
 	RightOperand Operand      `json:"rightOperand"`
 }
 
-func (c AbacComparison) ToGqlInput() raitoType.AbacComparisonExpressionComparisonInput {
-	return raitoType.AbacComparisonExpressionComparisonInput{
+func (c AbacComparison) ToGqlInput() *raitoType.AbacComparisonExpressionComparisonInput {
+	return &raitoType.AbacComparisonExpressionComparisonInput{
 		Operator:     raitoType.AbacComparisonExpressionComparisonOperator(c.Operator.String()),
 		LeftOperand:  c.LeftOperand,
 		RightOperand: c.RightOperand.ToGqlInput(),
 	}
 }
 
+// Operand is the right operand of a comparison: either a literal value or a reference to an attribute of the user.
 type Operand struct {
-	Literal *Literal `json:"literal,omitempty"`
+	Literal   *Literal   `json:"literal,omitempty"`
+	Reference *Reference `json:"reference,omitempty"`
 }
 
 func (o Operand) ToGqlInput() raitoType.AbacComparisonExpressionOperandInput {
 	var literal *raitoType.AbacComparisonExpressionLiteral
+	var reference *raitoType.AbacComparisonExpressionReferenceInput
 
 	if o.Literal != nil {
 		literal = utils.Ptr(o.Literal.ToGqlInput())
 	}
 
+	if o.Reference != nil {
+		reference = utils.Ptr(o.Reference.ToGqlInput())
+	}
+
 	return raitoType.AbacComparisonExpressionOperandInput{
-		Literal: literal,
+		Literal:   literal,
+		Reference: reference,
 	}
 }
 
+// Reference refers to an attribute of the user for whom the rule is evaluated, e.g. the department of the user requesting access.
+type Reference struct {
+	UserAttribute string `json:"userAttribute"`
+}
+
+func (r Reference) ToGqlInput() raitoType.AbacComparisonExpressionReferenceInput {
+	return raitoType.AbacComparisonExpressionReferenceInput{
+		UserAttribute: r.UserAttribute,
+	}
+}
+
+// ReferencesInGqlInput returns the comparisons of the gql input that compare with an attribute of the user, in the abac expression language.
+func ReferencesInGqlInput(input *raitoType.AbacComparisonExpressionInput) []string {
+	if input == nil {
+		return nil
+	}
+
+	var references []string
+
+	if comparison := input.Comparison; comparison != nil && comparison.RightOperand.Reference != nil {
+		reference := Reference{UserAttribute: comparison.RightOperand.Reference.UserAttribute}
+
+		if operator, err := AbacOperatorString(string(comparison.Operator)); err == nil {
+			references = append(references, AbacComparison{Operator: operator, LeftOperand: comparison.LeftOperand, RightOperand: Operand{Reference: &reference}}.toDsl())
+		} else {
+			references = append(references, fmt.Sprintf("%s(%s, %s)", comparison.Operator, strconv.Quote(comparison.LeftOperand), reference.toDsl()))
+		}
+	}
+
+	if input.Aggregator != nil {
+		for i := range input.Aggregator.Operands {
+			references = append(references, ReferencesInGqlInput(&input.Aggregator.Operands[i])...)
+		}
+	}
+
+	if input.UnaryExpression != nil {
+		references = append(references, ReferencesInGqlInput(&input.UnaryExpression.Operand)...)
+	}
+
+	return references
+}
+
 type Literal struct {
 	Bool       *bool    `json:"bool,omitempty"`
 	String     *string  `json:"string,omitempty"`
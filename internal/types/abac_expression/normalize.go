@@ -0,0 +1,193 @@
+package abac_expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/raito-io/terraform-provider-raito/internal/utils"
+)
+
+// maxNormalizedConjunctions limits the size of a normalised rule, as distributing And over Or can grow a rule exponentially.
+const maxNormalizedConjunctions = 1000
+
+// Normalize returns the rule in the form required by Raito Cloud: an Or aggregator of And aggregators of which each operand is a comparison, a literal or a negated comparison.
+// Negations are pushed down to the comparisons (De Morgan), nested aggregators are flattened and And is distributed over Or.
+// A rule that consists of a single literal is returned as is.
+func (b BinaryExpression) Normalize() (*BinaryExpression, error) {
+	if b.isLiteral() {
+		return &b, nil
+	}
+
+	conjunctions, err := b.conjunctions(false)
+	if err != nil {
+		return nil, err
+	}
+
+	operands := make([]BinaryExpression, 0, len(conjunctions))
+	for _, conjunction := range conjunctions {
+		operands = append(operands, BinaryExpression{Aggregator: &Aggregator{Operator: AggregatorOperatorAnd, Operands: conjunction}})
+	}
+
+	return &BinaryExpression{Aggregator: &Aggregator{Operator: AggregatorOperatorOr, Operands: operands}}, nil
+}
+
+// Equivalent indicates that both rules have the same normalised form.
+// The order of the operands of aggregators and of the values of string lists is ignored.
+func (b BinaryExpression) Equivalent(other BinaryExpression) bool {
+	normalized, err := b.Normalize()
+	if err != nil {
+		return b.Equal(other)
+	}
+
+	otherNormalized, err := other.Normalize()
+	if err != nil {
+		return b.Equal(other)
+	}
+
+	return normalized.Equal(*otherNormalized)
+}
+
+// Equal indicates that both rules have the same structure.
+// The operands of aggregators and the values of string lists are compared as multisets.
+func (b BinaryExpression) Equal(other BinaryExpression) bool {
+	return reflect.DeepEqual(b.canonical(), other.canonical())
+}
+
+// canonical returns a copy of the expression with the operands of aggregators and the values of string lists sorted.
+func (b BinaryExpression) canonical() BinaryExpression {
+	result := b
+
+	if b.Comparison != nil && b.Comparison.RightOperand.Literal != nil && b.Comparison.RightOperand.Literal.StringList != nil {
+		literal := *b.Comparison.RightOperand.Literal
+		literal.StringList = slices.Sorted(slices.Values(literal.StringList))
+
+		comparison := *b.Comparison
+		comparison.RightOperand.Literal = &literal
+		result.Comparison = &comparison
+	}
+
+	if b.Aggregator != nil {
+		operands := make([]BinaryExpression, 0, len(b.Aggregator.Operands))
+		for _, operand := range b.Aggregator.Operands {
+			operands = append(operands, operand.canonical())
+		}
+
+		slices.SortFunc(operands, func(a, b BinaryExpression) int {
+			return strings.Compare(a.sortKey(), b.sortKey())
+		})
+
+		result.Aggregator = &Aggregator{Operator: b.Aggregator.Operator, Operands: operands}
+	}
+
+	if b.UnaryExpression != nil {
+		result.UnaryExpression = &UnaryExpression{Operator: b.UnaryExpression.Operator, Operand: b.UnaryExpression.Operand.canonical()}
+	}
+
+	return result
+}
+
+func (b BinaryExpression) sortKey() string {
+	key, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("%#v", b)
+	}
+
+	return string(key)
+}
+
+// conjunctions returns the (negated) expression as a list of conjunctions of conditions, of which at least one should match.
+func (b BinaryExpression) conjunctions(negate bool) ([][]BinaryExpression, error) {
+	if countSet(b.Literal != nil, b.Comparison != nil, b.Aggregator != nil, b.UnaryExpression != nil) != 1 {
+		// Invalid expressions are kept as is, so they are reported by Raito Cloud.
+		return [][]BinaryExpression{{b.condition(negate)}}, nil
+	}
+
+	if b.UnaryExpression != nil && b.UnaryExpression.Operator == UnaryOperatorNot {
+		return b.UnaryExpression.Operand.conjunctions(!negate)
+	}
+
+	if b.Aggregator != nil {
+		// By De Morgan, a negated And is an Or of the negated operands and vice versa.
+		if (b.Aggregator.Operator == AggregatorOperatorAnd) != negate {
+			return b.Aggregator.andConjunctions(negate)
+		}
+
+		return b.Aggregator.orConjunctions(negate)
+	}
+
+	return [][]BinaryExpression{{b.condition(negate)}}, nil
+}
+
+// condition returns the (negated) expression as a single condition.
+func (b BinaryExpression) condition(negate bool) BinaryExpression {
+	if !negate {
+		return b
+	}
+
+	if b.isLiteral() {
+		return BinaryExpression{Literal: utils.Ptr(!*b.Literal)}
+	}
+
+	return BinaryExpression{UnaryExpression: &UnaryExpression{Operator: UnaryOperatorNot, Operand: b}}
+}
+
+func (a Aggregator) orConjunctions(negate bool) ([][]BinaryExpression, error) {
+	var result [][]BinaryExpression
+
+	for _, operand := range a.Operands {
+		conjunctions, err := operand.conjunctions(negate)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, conjunctions...)
+
+		if len(result) > maxNormalizedConjunctions {
+			return nil, tooComplexError()
+		}
+	}
+
+	return result, nil
+}
+
+func (a Aggregator) andConjunctions(negate bool) ([][]BinaryExpression, error) {
+	result := [][]BinaryExpression{{}}
+
+	for _, operand := range a.Operands {
+		conjunctions, err := operand.conjunctions(negate)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(result)*len(conjunctions) > maxNormalizedConjunctions {
+			return nil, tooComplexError()
+		}
+
+		distributed := make([][]BinaryExpression, 0, len(result)*len(conjunctions))
+
+		for _, left := range result {
+			for _, right := range conjunctions {
+				conjunction := make([]BinaryExpression, 0, len(left)+len(right))
+				conjunction = append(conjunction, left...)
+				conjunction = append(conjunction, right...)
+
+				distributed = append(distributed, conjunction)
+			}
+		}
+
+		result = distributed
+	}
+
+	return result, nil
+}
+
+func (b BinaryExpression) isLiteral() bool {
+	return b.Literal != nil && b.Comparison == nil && b.Aggregator == nil && b.UnaryExpression == nil
+}
+
+func tooComplexError() error {
+	return fmt.Errorf("the rule expands to more than %d And-aggregators in the Or-aggregator required by Raito Cloud, simplify the rule", maxNormalizedConjunctions)
+}
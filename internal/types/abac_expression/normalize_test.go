@@ -0,0 +1,145 @@
+package abac_expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func testTag(key string) string {
+	return fmt.Sprintf(`{"comparison":{"operator":"HasTag","leftOperand":%q,"rightOperand":{"literal":{"string":"x"}}}}`, key)
+}
+
+func testNot(expression string) string {
+	return fmt.Sprintf(`{"unaryExpression":{"operator":"Not","expression":%s}}`, expression)
+}
+
+func testAggregator(operator string, operands ...string) string {
+	return fmt.Sprintf(`{"aggregator":{"operator":%q,"operands":[%s]}}`, operator, strings.Join(operands, ","))
+}
+
+func testRule(t *testing.T, rule string) BinaryExpression {
+	t.Helper()
+
+	var expression BinaryExpression
+	if err := json.Unmarshal([]byte(rule), &expression); err != nil {
+		t.Fatalf("unmarshal rule %s: %v", rule, err)
+	}
+
+	return expression
+}
+
+func TestBinaryExpression_Normalize(t *testing.T) {
+	a, b, c := testTag("a"), testTag("b"), testTag("c")
+
+	tests := []struct {
+		name     string
+		rule     string
+		expected string
+	}{
+		{
+			name:     "normalized rule is unchanged",
+			rule:     testAggregator("Or", testAggregator("And", a, testNot(b)), testAggregator("And", c)),
+			expected: testAggregator("Or", testAggregator("And", a, testNot(b)), testAggregator("And", c)),
+		},
+		{
+			name:     "single comparison",
+			rule:     a,
+			expected: testAggregator("Or", testAggregator("And", a)),
+		},
+		{
+			name:     "flatten nested aggregators",
+			rule:     testAggregator("Or", a, testAggregator("Or", b, testAggregator("And", c, testAggregator("And", a)))),
+			expected: testAggregator("Or", testAggregator("And", a), testAggregator("And", b), testAggregator("And", c, a)),
+		},
+		{
+			name:     "distribute And over Or",
+			rule:     testAggregator("And", testAggregator("Or", a, b), c),
+			expected: testAggregator("Or", testAggregator("And", a, c), testAggregator("And", b, c)),
+		},
+		{
+			name:     "De Morgan",
+			rule:     testNot(testAggregator("And", a, testAggregator("Or", b, testNot(c)))),
+			expected: testAggregator("Or", testAggregator("And", testNot(a)), testAggregator("And", testNot(b), c)),
+		},
+		{
+			name:     "negated literal",
+			rule:     testAggregator("And", a, testNot(`{"literal":true}`)),
+			expected: testAggregator("Or", testAggregator("And", a, `{"literal":false}`)),
+		},
+		{
+			name:     "literal rule",
+			rule:     `{"literal":true}`,
+			expected: `{"literal":true}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			normalized, err := testRule(t, test.rule).Normalize()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if errs := normalized.ValidateNormalized(); errs != nil {
+				t.Errorf("normalized rule is invalid: %v", errs)
+			}
+
+			normalizedJson, err := json.Marshal(normalized)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := testRule(t, test.expected)
+
+			expectedJson, err := json.Marshal(expected)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(normalizedJson) != string(expectedJson) {
+				t.Errorf("unexpected normalized rule\n got: %s\nwant: %s", normalizedJson, expectedJson)
+			}
+
+			if !testRule(t, test.rule).Equivalent(expected) {
+				t.Errorf("rule is not equivalent to its normalized form")
+			}
+		})
+	}
+}
+
+func TestBinaryExpression_NormalizeTooComplex(t *testing.T) {
+	operands := make([]string, 0, 11)
+	for i := range 11 {
+		operands = append(operands, testAggregator("Or", testTag(fmt.Sprintf("a%d", i)), testTag(fmt.Sprintf("b%d", i))))
+	}
+
+	if _, err := testRule(t, testAggregator("And", operands...)).Normalize(); err == nil {
+		t.Error("expected an error for a rule that expands to 2048 conjunctions")
+	}
+}
+
+func TestBinaryExpression_Equivalent(t *testing.T) {
+	a, b, c := testTag("a"), testTag("b"), testTag("c")
+
+	if !testRule(t, testAggregator("Or", testAggregator("And", a, b), c)).Equivalent(testRule(t, testAggregator("Or", c, testAggregator("And", b, a)))) {
+		t.Error("expected rules with a different order of operands to be equivalent")
+	}
+
+	if testRule(t, testAggregator("And", a, a, b)).Equivalent(testRule(t, testAggregator("And", a, b, b))) {
+		t.Error("expected operands to be compared as multisets")
+	}
+
+	stringList := func(values string) string {
+		return fmt.Sprintf(`{"comparison":{"operator":"PropertyIn","leftOperand":"type","rightOperand":{"literal":{"stringList":%s}}}}`, values)
+	}
+
+	if !testRule(t, stringList(`["table","view"]`)).Equivalent(testRule(t, stringList(`["view","table"]`))) {
+		t.Error("expected string lists with a different order to be equivalent")
+	}
+
+	if !testRule(t, testNot(testAggregator("Or", a, b))).Equivalent(testRule(t, testAggregator("And", testNot(a), testNot(b)))) {
+		t.Error("expected rules to be equivalent")
+	}
+}
@@ -0,0 +1,209 @@
+package abac_expression
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError is a structural error in an abac rule.
+type ValidationError struct {
+	// Path of the invalid element in the json representation of the rule (e.g. aggregator.operands[1].comparison.rightOperand). Empty for the root of the rule.
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidationErrors contains all structural errors found in an abac rule.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// ruleLevel is the depth of an expression in an abac rule.
+type ruleLevel int
+
+const (
+	ruleLevelOr ruleLevel = iota
+	ruleLevelAnd
+	ruleLevelCondition
+	ruleLevelNegatedCondition
+	ruleLevelAny
+)
+
+// Validate validates the structure of the abac rule. Nil is returned if the rule is valid.
+//
+// Aggregators and unary expressions can be nested arbitrarily, as the rule is brought in the form required by Raito Cloud by Normalize.
+func (b *BinaryExpression) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	b.validate("", ruleLevelAny, &errs)
+
+	return errs
+}
+
+// ValidateNormalized validates that the abac rule has the form required by Raito Cloud. Nil is returned if the rule is valid.
+//
+// The rule should be an Or aggregator of And aggregators of which each operand is a comparison, a literal or a negated comparison or literal.
+// A single literal is also accepted as the complete rule.
+func (b *BinaryExpression) ValidateNormalized() ValidationErrors {
+	var errs ValidationErrors
+
+	if b.isLiteral() {
+		return nil
+	}
+
+	b.validate("", ruleLevelOr, &errs)
+
+	return errs
+}
+
+func (b *BinaryExpression) validate(p string, level ruleLevel, errs *ValidationErrors) {
+	if expressionsSet := countSet(b.Literal != nil, b.Comparison != nil, b.Aggregator != nil, b.UnaryExpression != nil); expressionsSet != 1 {
+		errs.add(p, fmt.Sprintf("exactly one of literal, comparison, aggregator or unaryExpression must be set, got %d", expressionsSet))
+
+		return
+	}
+
+	switch level {
+	case ruleLevelOr, ruleLevelAnd:
+		operator := AggregatorOperatorOr
+		if level == ruleLevelAnd {
+			operator = AggregatorOperatorAnd
+		}
+
+		if b.Aggregator == nil || b.Aggregator.Operator != operator {
+			errs.add(p, fmt.Sprintf("expected an aggregator with operator %s", operator))
+
+			return
+		}
+
+		b.Aggregator.validate(joinPath(p, "aggregator"), level+1, errs)
+	case ruleLevelCondition:
+		if b.Aggregator != nil {
+			errs.add(p, "expected a comparison, literal or unaryExpression, got an aggregator")
+		} else if b.Comparison != nil {
+			b.Comparison.validate(joinPath(p, "comparison"), errs)
+		} else if b.UnaryExpression != nil {
+			b.UnaryExpression.validate(joinPath(p, "unaryExpression"), ruleLevelNegatedCondition, errs)
+		}
+	case ruleLevelNegatedCondition:
+		if b.Aggregator != nil || b.UnaryExpression != nil {
+			errs.add(p, "expected a comparison or literal in a unaryExpression")
+		} else if b.Comparison != nil {
+			b.Comparison.validate(joinPath(p, "comparison"), errs)
+		}
+	case ruleLevelAny:
+		if b.Aggregator != nil {
+			b.Aggregator.validate(joinPath(p, "aggregator"), ruleLevelAny, errs)
+		} else if b.Comparison != nil {
+			b.Comparison.validate(joinPath(p, "comparison"), errs)
+		} else if b.UnaryExpression != nil {
+			b.UnaryExpression.validate(joinPath(p, "unaryExpression"), ruleLevelAny, errs)
+		}
+	}
+}
+
+func (a *Aggregator) validate(p string, operandLevel ruleLevel, errs *ValidationErrors) {
+	if len(a.Operands) == 0 {
+		errs.add(joinPath(p, "operands"), "at least one operand is required")
+
+		return
+	}
+
+	for i := range a.Operands {
+		a.Operands[i].validate(fmt.Sprintf("%s[%d]", joinPath(p, "operands"), i), operandLevel, errs)
+	}
+}
+
+func (u *UnaryExpression) validate(p string, operandLevel ruleLevel, errs *ValidationErrors) {
+	if !u.Operator.IsAUnaryOperator() {
+		errs.add(joinPath(p, "operator"), fmt.Sprintf("unsupported operator %s", u.Operator))
+	}
+
+	u.Operand.validate(joinPath(p, "expression"), operandLevel, errs)
+}
+
+func (c *AbacComparison) validate(p string, errs *ValidationErrors) {
+	if !c.Operator.IsAAbacOperator() {
+		errs.add(joinPath(p, "operator"), fmt.Sprintf("unsupported operator %s", c.Operator))
+
+		return
+	}
+
+	if c.LeftOperand == "" {
+		errs.add(joinPath(p, "leftOperand"), "must be set")
+	}
+
+	operandPath := joinPath(p, "rightOperand")
+
+	if operandsSet := countSet(c.RightOperand.Literal != nil, c.RightOperand.Reference != nil); operandsSet != 1 {
+		errs.add(operandPath, fmt.Sprintf("exactly one of literal or reference must be set, got %d", operandsSet))
+
+		return
+	}
+
+	if reference := c.RightOperand.Reference; reference != nil {
+		if c.Operator == AbacOperatorPropertyIn {
+			errs.add(joinPath(operandPath, "reference"), fmt.Sprintf("operator %s expects a stringList literal", c.Operator))
+		} else if reference.UserAttribute == "" {
+			errs.add(joinPath(operandPath, "reference.userAttribute"), "must be set")
+		}
+
+		return
+	}
+
+	literal := c.RightOperand.Literal
+
+	literalPath := joinPath(operandPath, "literal")
+
+	if literalsSet := countSet(literal.Bool != nil, literal.String != nil, literal.StringList != nil); literalsSet != 1 {
+		errs.add(literalPath, fmt.Sprintf("exactly one of bool, string or stringList must be set, got %d", literalsSet))
+
+		return
+	}
+
+	if c.Operator == AbacOperatorPropertyIn {
+		if literal.StringList == nil {
+			errs.add(literalPath, fmt.Sprintf("operator %s expects a stringList", c.Operator))
+		}
+	} else if literal.String == nil {
+		errs.add(literalPath, fmt.Sprintf("operator %s expects a string", c.Operator))
+	}
+}
+
+func (e *ValidationErrors) add(p string, message string) {
+	*e = append(*e, ValidationError{Path: p, Message: message})
+}
+
+func joinPath(p string, element string) string {
+	if p == "" {
+		return element
+	}
+
+	return p + "." + element
+}
+
+func countSet(set ...bool) int {
+	count := 0
+
+	for _, isSet := range set {
+		if isSet {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,114 @@
+package abac_expression
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBinaryExpression_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		rule           string
+		normalized     bool
+		expectedErrors []string
+	}{
+		{
+			name: "valid rule",
+			rule: `{"aggregator":{"operator":"Or","operands":[{"aggregator":{"operator":"And","operands":[
+				{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Finance"}}}},
+				{"unaryExpression":{"operator":"Not","expression":{"comparison":{"operator":"PropertyIn","leftOperand":"type","rightOperand":{"literal":{"stringList":["view"]}}}}}},
+				{"literal":true}
+			]}}]}}`,
+		},
+		{
+			name: "literal rule",
+			rule: `{"literal":true}`,
+		},
+		{
+			name:           "empty rule",
+			rule:           `{}`,
+			expectedErrors: []string{"exactly one of literal, comparison, aggregator or unaryExpression must be set, got 0"},
+		},
+		{
+			name:           "comparison on first level",
+			rule:           `{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Finance"}}}}`,
+			normalized:     true,
+			expectedErrors: []string{"expected an aggregator with operator Or"},
+		},
+		{
+			name:           "Or on second level",
+			rule:           `{"aggregator":{"operator":"Or","operands":[{"aggregator":{"operator":"Or","operands":[{"literal":true}]}}]}}`,
+			normalized:     true,
+			expectedErrors: []string{"aggregator.operands[0]: expected an aggregator with operator And"},
+		},
+		{
+			name:           "empty operands",
+			rule:           `{"aggregator":{"operator":"Or","operands":[]}}`,
+			expectedErrors: []string{"aggregator.operands: at least one operand is required"},
+		},
+		{
+			name: "invalid literals",
+			rule: `{"aggregator":{"operator":"Or","operands":[{"aggregator":{"operator":"And","operands":[
+				{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Finance","bool":true}}}},
+				{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{}}},
+				{"comparison":{"operator":"PropertyIn","leftOperand":"","rightOperand":{"literal":{"string":"view"}}}}
+			]}}]}}`,
+			expectedErrors: []string{
+				"aggregator.operands[0].aggregator.operands[0].comparison.rightOperand.literal: exactly one of bool, string or stringList must be set, got 2",
+				"aggregator.operands[0].aggregator.operands[1].comparison.rightOperand: exactly one of literal or reference must be set, got 0",
+				"aggregator.operands[0].aggregator.operands[2].comparison.leftOperand: must be set",
+				"aggregator.operands[0].aggregator.operands[2].comparison.rightOperand.literal: operator PropertyIn expects a stringList",
+			},
+		},
+		{
+			name: "references",
+			rule: `{"aggregator":{"operator":"And","operands":[
+				{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"reference":{"userAttribute":"department"}}}},
+				{"comparison":{"operator":"PropertyEquals","leftOperand":"owner","rightOperand":{"reference":{"userAttribute":""}}}},
+				{"comparison":{"operator":"PropertyIn","leftOperand":"type","rightOperand":{"reference":{"userAttribute":"types"}}}},
+				{"comparison":{"operator":"HasTag","leftOperand":"department","rightOperand":{"literal":{"string":"Finance"},"reference":{"userAttribute":"department"}}}}
+			]}}`,
+			expectedErrors: []string{
+				"aggregator.operands[1].comparison.rightOperand.reference.userAttribute: must be set",
+				"aggregator.operands[2].comparison.rightOperand.reference: operator PropertyIn expects a stringList literal",
+				"aggregator.operands[3].comparison.rightOperand: exactly one of literal or reference must be set, got 2",
+			},
+		},
+		{
+			name: "nested unary expression",
+			rule: `{"aggregator":{"operator":"Or","operands":[{"aggregator":{"operator":"And","operands":[
+				{"unaryExpression":{"operator":"Not","expression":{"unaryExpression":{"operator":"Not","expression":{"literal":true}}}}}
+			]}}]}}`,
+			normalized:     true,
+			expectedErrors: []string{"aggregator.operands[0].aggregator.operands[0].unaryExpression.expression: expected a comparison or literal in a unaryExpression"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var rule BinaryExpression
+			if err := json.Unmarshal([]byte(test.rule), &rule); err != nil {
+				t.Fatalf("unmarshal rule: %v", err)
+			}
+
+			errs := rule.Validate()
+			if test.normalized {
+				if len(errs) != 0 {
+					t.Fatalf("expected no errors for the rule before normalisation, got: %v", errs)
+				}
+
+				errs = rule.ValidateNormalized()
+			}
+
+			if len(errs) != len(test.expectedErrors) {
+				t.Fatalf("expected %d errors, got %d: %v", len(test.expectedErrors), len(errs), errs)
+			}
+
+			for i := range errs {
+				if errs[i].Error() != test.expectedErrors[i] {
+					t.Errorf("error %d: expected %q, got %q", i, test.expectedErrors[i], errs[i].Error())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Package fullname handles the dot-separated full names of data objects in Raito Cloud, e.g. MASTER_DATA.SALES.
+//
+// Parts of a full name that are empty or contain a dot or a double quote are enclosed in double quotes, in which double quotes are escaped by doubling them, e.g. MASTER_DATA."my.schema"."say ""hi""".
+// TestAccFullnameFunctions_RaitoFullNames checks this convention against the full names returned by Raito Cloud.
+package fullname
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	separator = '.'
+	quote     = '"'
+)
+
+var ErrEmptyFullName = errors.New("full name is empty")
+
+// Quote returns the part as it should appear in a full name. The part is enclosed in double quotes if it is empty or contains a dot or a double quote.
+func Quote(part string) string {
+	if part != "" && !strings.ContainsAny(part, string([]rune{separator, quote})) {
+		return part
+	}
+
+	return string(quote) + strings.ReplaceAll(part, string(quote), string([]rune{quote, quote})) + string(quote)
+}
+
+// Join returns the full name consisting of the given parts, see Quote.
+func Join(parts ...string) string {
+	quotedParts := make([]string, 0, len(parts))
+	for _, part := range parts {
+		quotedParts = append(quotedParts, Quote(part))
+	}
+
+	return strings.Join(quotedParts, string(separator))
+}
+
+// Split returns the unquoted parts of the full name. It is the inverse of Join.
+func Split(name string) ([]string, error) {
+	parts, _, err := split(name)
+
+	return parts, err
+}
+
+// Parent returns the full name of the parent of the data object with the given full name. An empty string is returned if the name has a single part.
+func Parent(name string) (string, error) {
+	parts, starts, err := split(name)
+	if err != nil {
+		return "", err
+	}
+
+	if len(parts) == 1 {
+		return "", nil
+	}
+
+	// The parent is kept as written, without the separator before the last part.
+	return name[:starts[len(starts)-1]-1], nil
+}
+
+// split returns the unquoted parts of the full name and the offset of each part in the name.
+func split(name string) (parts []string, starts []int, _ error) {
+	if name == "" {
+		return nil, nil, ErrEmptyFullName
+	}
+
+	offset := 0
+
+	for {
+		starts = append(starts, offset)
+
+		part, next, err := splitPart(name, offset)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		parts = append(parts, part)
+
+		if next >= len(name) {
+			return parts, starts, nil
+		}
+
+		// name[next] is a separator
+		offset = next + 1
+
+		if offset == len(name) {
+			return nil, nil, fmt.Errorf("empty part at the end of full name %q", name)
+		}
+	}
+}
+
+// splitPart returns the unquoted part starting at the given offset and the offset after the part.
+func splitPart(name string, offset int) (string, int, error) {
+	if name[offset] != quote {
+		end := strings.IndexRune(name[offset:], separator)
+		if end == -1 {
+			return name[offset:], len(name), nil
+		} else if end == 0 {
+			return "", 0, fmt.Errorf("empty part at position %d of full name %q", offset+1, name)
+		}
+
+		return name[offset : offset+end], offset + end, nil
+	}
+
+	var part strings.Builder
+
+	for i := offset + 1; i < len(name); i++ {
+		if name[i] != quote {
+			part.WriteByte(name[i])
+
+			continue
+		}
+
+		if i+1 < len(name) && name[i+1] == quote {
+			part.WriteByte(quote)
+			i++
+
+			continue
+		}
+
+		if i+1 < len(name) && name[i+1] != separator {
+			return "", 0, fmt.Errorf("unexpected character after quoted part at position %d of full name %q", i+2, name)
+		}
+
+		return part.String(), i + 1, nil
+	}
+
+	return "", 0, fmt.Errorf("unterminated quote at position %d of full name %q", offset+1, name)
+}
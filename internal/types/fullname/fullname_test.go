@@ -0,0 +1,77 @@
+package fullname
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestJoinSplit(t *testing.T) {
+	tests := []struct {
+		parts    []string
+		expected string
+	}{
+		{parts: []string{"MASTER_DATA"}, expected: `MASTER_DATA`},
+		{parts: []string{"MASTER_DATA", "SALES", "CUSTOMERS"}, expected: `MASTER_DATA.SALES.CUSTOMERS`},
+		{parts: []string{"MASTER_DATA", "my.schema", "CUSTOMERS"}, expected: `MASTER_DATA."my.schema".CUSTOMERS`},
+		{parts: []string{"MASTER_DATA", `say "hi"`}, expected: `MASTER_DATA."say ""hi"""`},
+		{parts: []string{"a b", "c-d"}, expected: `a b.c-d`},
+		{parts: []string{"MASTER_DATA", ""}, expected: `MASTER_DATA.""`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			if name := Join(test.parts...); name != test.expected {
+				t.Errorf("unexpected full name\n got: %s\nwant: %s", name, test.expected)
+			}
+
+			parts, err := Split(test.expected)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !slices.Equal(parts, test.parts) {
+				t.Errorf("unexpected parts %q, expected %q", parts, test.parts)
+			}
+		})
+	}
+}
+
+func TestSplit_Errors(t *testing.T) {
+	tests := map[string]string{
+		``:                    "full name is empty",
+		`MASTER_DATA.`:        `empty part at the end of full name "MASTER_DATA."`,
+		`MASTER_DATA..SALES`:  `empty part at position 13 of full name "MASTER_DATA..SALES"`,
+		`MASTER_DATA."SALES`:  `unterminated quote at position 13 of full name "MASTER_DATA.\"SALES"`,
+		`"MASTER"_DATA.SALES`: `unexpected character after quoted part at position 9 of full name "\"MASTER\"_DATA.SALES"`,
+	}
+
+	for name, expected := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Split(name); err == nil || err.Error() != expected {
+				t.Errorf("unexpected error\n got: %v\nwant: %s", err, expected)
+			}
+		})
+	}
+}
+
+func TestParent(t *testing.T) {
+	tests := map[string]string{
+		`MASTER_DATA`:                       "",
+		`MASTER_DATA.SALES`:                 `MASTER_DATA`,
+		`MASTER_DATA."my.schema".CUSTOMERS`: `MASTER_DATA."my.schema"`,
+		`MASTER_DATA."a.b"`:                 `MASTER_DATA`,
+	}
+
+	for name, expected := range tests {
+		t.Run(name, func(t *testing.T) {
+			parent, err := Parent(name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if parent != expected {
+				t.Errorf("unexpected parent %q, expected %q", parent, expected)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,11 +14,12 @@ import (
 var _ datasource.DataSource = (*UserDataSource)(nil)
 
 type UserDataSourceModel struct {
-	Id        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Email     types.String `tfsdk:"email"`
-	Type      types.String `tfsdk:"type"`
-	RaitoUser types.Bool   `tfsdk:"raito_user"`
+	Id        types.String   `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	Email     types.String   `tfsdk:"email"`
+	Type      types.String   `tfsdk:"type"`
+	RaitoUser types.Bool     `tfsdk:"raito_user"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 type UserDataSource struct {
@@ -32,7 +34,7 @@ func (u *UserDataSource) Metadata(_ context.Context, request datasource.Metadata
 	response.TypeName = request.ProviderTypeName + "_user"
 }
 
-func (u *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+func (u *UserDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -76,6 +78,9 @@ func (u *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				MarkdownDescription: "Whether the requested user is a Raito user",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": dataSourceTimeoutsBlock(ctx),
+		},
 		Description:         "Find a user by email address",
 		MarkdownDescription: "Find a Raito [User](https://docs.raito.io/docs/cloud/admin/user_management) by email address",
 	}
@@ -90,6 +95,16 @@ func (u *UserDataSource) Read(ctx context.Context, request datasource.ReadReques
 		return
 	}
 
+	readTimeout, diagnostics := data.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
 	user, err := u.client.User().GetUserByEmail(ctx, data.Email.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError("Failed to get user", err.Error())
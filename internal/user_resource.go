@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,14 +28,15 @@ import (
 var _ resource.Resource = (*UserResource)(nil)
 
 type UserResourceModel struct {
-	Id                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	Email             types.String `tfsdk:"email"`
-	Type              types.String `tfsdk:"type"`
-	Password          types.String `tfsdk:"password"`
-	PasswordWo        types.String `tfsdk:"password_wo"`
-	PasswordWoVersion types.Int32  `tfsdk:"password_wo_version"`
-	RaitoUser         types.Bool   `tfsdk:"raito_user"`
+	Id                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Email             types.String   `tfsdk:"email"`
+	Type              types.String   `tfsdk:"type"`
+	Password          types.String   `tfsdk:"password"`
+	PasswordWo        types.String   `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int32    `tfsdk:"password_wo_version"`
+	RaitoUser         types.Bool     `tfsdk:"raito_user"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *UserResourceModel) ToUserInput() raitoTypes.UserInput {
@@ -168,6 +170,9 @@ func (u *UserResource) Schema(ctx context.Context, request resource.SchemaReques
 				Default:             booldefault.StaticBool(true),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 		Description:         "User resource",
 		MarkdownDescription: "The resource for representing a [User](https://docs.raito.io/docs/cloud/admin/user_management) in Raito.",
 		Version:             1,
@@ -183,6 +188,16 @@ func (u *UserResource) Create(ctx context.Context, request resource.CreateReques
 		return
 	}
 
+	createTimeout, diagnostics := data.Timeouts.Create(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "create", createTimeout, &response.Diagnostics)
+	defer done()
+
 	user, err := u.client.User().GetUserByEmail(ctx, data.Email.ValueString())
 	if err != nil {
 		var notFoundErr *raitoTypes.ErrNotFound
@@ -259,6 +274,16 @@ func (u *UserResource) Read(ctx context.Context, request resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diagnostics := stateData.Timeouts.Read(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "read", readTimeout, &response.Diagnostics)
+	defer done()
+
 	// Read user
 	user, err := u.client.User().GetUser(ctx, stateData.Id.ValueString())
 	if err != nil {
@@ -283,6 +308,7 @@ func (u *UserResource) Read(ctx context.Context, request resource.ReadRequest, r
 		Type:      types.StringValue(string(user.Type)),
 		Password:  stateData.Password,
 		RaitoUser: types.BoolValue(user.IsRaitoUser),
+		Timeouts:  stateData.Timeouts,
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &actualData)...)
@@ -299,6 +325,16 @@ func (u *UserResource) Update(ctx context.Context, request resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diagnostics := planData.Timeouts.Update(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "update", updateTimeout, &response.Diagnostics)
+	defer done()
+
 	// Update user
 	user, err := u.client.User().UpdateUser(ctx, planData.Id.ValueString(), planData.ToUserInput())
 	if err != nil {
@@ -351,6 +387,16 @@ func (u *UserResource) Delete(ctx context.Context, request resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diagnostics := stateData.Timeouts.Delete(ctx, defaultTimeout)
+	response.Diagnostics.Append(diagnostics...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, "delete", deleteTimeout, &response.Diagnostics)
+	defer done()
+
 	if stateData.RaitoUser.ValueBool() {
 		_, err := u.client.User().RemoveAsRaitoUser(ctx, stateData.Id.ValueString())
 		if err != nil {
@@ -395,18 +441,18 @@ func (u *UserResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*sdk.RaitoClient)
+	providerData, ok := req.ProviderData.(*RaitoResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.RaitoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *internal.RaitoResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	if client == nil {
+	if providerData == nil || providerData.Client == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
 			"Expected *sdk.RaitoClient, not to be nil.",
@@ -415,7 +461,7 @@ func (u *UserResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	u.client = client
+	u.client = providerData.Client
 }
 
 func (u *UserResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
@@ -33,6 +33,22 @@ func StringSetToSlice(ctx context.Context, set types.Set) (_ []string, diagnosti
 	return result, diagnostics
 }
 
+func StringListToSlice(ctx context.Context, list types.List) (_ []string, diagnostics diag.Diagnostics) {
+	var stringTypes []types.String
+	diagnostics.Append(list.ElementsAs(ctx, &stringTypes, false)...)
+
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	result := make([]string, len(stringTypes))
+	for i, value := range stringTypes {
+		result[i] = value.ValueString()
+	}
+
+	return result, diagnostics
+}
+
 func SliceToStringSet(_ context.Context, values []string) (types.Set, diag.Diagnostics) {
 	stringTypes := make([]attr.Value, len(values))
 	for i, value := range values {
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"path"
+)
+
+// ValidateGlob returns path.ErrBadPattern if the pattern is not a valid glob pattern, as accepted by path.Match.
+// Unlike path.Match, the complete pattern is checked, independent of the name it is matched against.
+func ValidateGlob(pattern string) error {
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+			if i >= len(pattern) {
+				return path.ErrBadPattern
+			}
+		case '[':
+			end, ok := globClassEnd(pattern, i+1)
+			if !ok {
+				return path.ErrBadPattern
+			}
+
+			i = end
+		}
+	}
+
+	return nil
+}
+
+// globClassEnd returns the index of the ']' that closes the character class starting at start.
+// A character class contains at least one character or range of characters, optionally negated with '^'.
+func globClassEnd(pattern string, start int) (int, bool) {
+	i := start
+	if i < len(pattern) && pattern[i] == '^' {
+		i++
+	}
+
+	for ranges := 0; ; ranges++ {
+		if i >= len(pattern) {
+			return 0, false
+		}
+
+		if pattern[i] == ']' && ranges > 0 {
+			return i, true
+		}
+
+		var ok bool
+
+		if i, ok = globClassChar(pattern, i); !ok {
+			return 0, false
+		}
+
+		if i < len(pattern) && pattern[i] == '-' {
+			if i, ok = globClassChar(pattern, i+1); !ok {
+				return 0, false
+			}
+		}
+	}
+}
+
+// globClassChar returns the index after the (escaped) character at i in a character class.
+func globClassChar(pattern string, i int) (int, bool) {
+	if i >= len(pattern) || pattern[i] == '-' || pattern[i] == ']' {
+		return 0, false
+	}
+
+	if pattern[i] == '\\' {
+		i++
+		if i >= len(pattern) {
+			return 0, false
+		}
+	}
+
+	return i + 1, true
+}
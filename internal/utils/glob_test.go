@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"errors"
+	"path"
+	"testing"
+)
+
+func TestValidateGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		wantErr bool
+	}{
+		{pattern: ""},
+		{pattern: "*"},
+		{pattern: "CUSTOMER_*"},
+		{pattern: "CUSTOMER_?"},
+		{pattern: "CUSTOMER_[a-z]*"},
+		{pattern: "CUSTOMER_[^0-9]"},
+		{pattern: `CUSTOMER_[\]]`},
+		{pattern: `CUSTOMER_\*`},
+		{pattern: "*]"},
+		{pattern: "[", wantErr: true},
+		{pattern: "CUST_*[", wantErr: true},
+		{pattern: "CUSTOMER_[a-", wantErr: true},
+		{pattern: "CUSTOMER_[]", wantErr: true},
+		{pattern: "CUSTOMER_[^]", wantErr: true},
+		{pattern: "CUSTOMER_[-a]", wantErr: true},
+		{pattern: "CUSTOMER_[a-]", wantErr: true},
+		{pattern: `CUSTOMER_\`, wantErr: true},
+		{pattern: `CUSTOMER_[\`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			err := ValidateGlob(tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateGlob(%q) = %v, wantErr %v", tt.pattern, err, tt.wantErr)
+			}
+
+			if err != nil && !errors.Is(err, path.ErrBadPattern) {
+				t.Errorf("ValidateGlob(%q) = %v, want path.ErrBadPattern", tt.pattern, err)
+			}
+
+			// Valid patterns are accepted by path.Match, invalid ones are rejected for any name.
+			if _, matchErr := path.Match(tt.pattern, "CUSTOMER_a"); !tt.wantErr && matchErr != nil {
+				t.Errorf("path.Match(%q) = %v, expected a valid pattern", tt.pattern, matchErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/raito-io/golang-set/set"
+	raitoType "github.com/raito-io/sdk-go/types"
+)
+
+// whoItemExpiriesKey is the private state key in which the expiry timestamps of who-items with expires_after are recorded.
+const whoItemExpiriesKey = "who_item_expiries"
+
+// whoItemExpiries maps the key of a who-item with expires_after on the expiry timestamp computed by Raito Cloud.
+type whoItemExpiries map[string]time.Time
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getWhoItemExpiries returns the expiry timestamps recorded in the private state of the resource.
+func getWhoItemExpiries(ctx context.Context, private privateStateGetter) (whoItemExpiries, diag.Diagnostics) {
+	expiries := whoItemExpiries{}
+
+	value, diagnostics := private.GetKey(ctx, whoItemExpiriesKey)
+	if diagnostics.HasError() || len(value) == 0 {
+		return expiries, diagnostics
+	}
+
+	if err := json.Unmarshal(value, &expiries); err != nil {
+		diagnostics.AddError("Failed to read who-item expiries from private state", err.Error())
+	}
+
+	return expiries, diagnostics
+}
+
+// setWhoItemExpiries records the expiry timestamps in the private state of the resource.
+func setWhoItemExpiries(ctx context.Context, private privateStateSetter, expiries whoItemExpiries) diag.Diagnostics {
+	if len(expiries) == 0 {
+		return private.SetKey(ctx, whoItemExpiriesKey, nil)
+	}
+
+	value, err := json.Marshal(expiries)
+	if err != nil {
+		var diagnostics diag.Diagnostics
+		diagnostics.AddError("Failed to write who-item expiries to private state", err.Error())
+
+		return diagnostics
+	}
+
+	return private.SetKey(ctx, whoItemExpiriesKey, value)
+}
+
+// whoItemAttributeTypes are the attribute types of a single who-item.
+var whoItemAttributeTypes = map[string]attr.Type{
+	"user":             types.StringType,
+	"group":            types.StringType,
+	"access_control":   types.StringType,
+	"promise_duration": types.Int64Type,
+	"expires_at":       types.StringType,
+	"expires_after":    types.StringType,
+}
+
+// whoItemKey returns the prefixed key of the beneficiary of a who-item, as used to match who-items of the state with who-items in Raito Cloud.
+func whoItemKey(attributes map[string]attr.Value) (string, bool) {
+	if user, found := attributes["user"]; found && !user.IsNull() {
+		return _userPrefix(user.(types.String).ValueString()), true
+	} else if group, found := attributes["group"]; found && !group.IsNull() {
+		return _groupPrefix(group.(types.String).ValueString()), true
+	} else if accessControl, found := attributes["access_control"]; found && !accessControl.IsNull() {
+		return _accessControlPrefix(accessControl.(types.String).ValueString()), true
+	}
+
+	return "", false
+}
+
+// parseExpiresAt parses the expires_at attribute of a who-item. Nil is returned if the attribute is not set.
+func parseExpiresAt(value attr.Value) (*time.Time, error) {
+	expiresAt, ok := value.(types.String)
+	if !ok || expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid RFC3339 timestamp (e.g. 2025-01-31T18:00:00Z)", expiresAt.ValueString())
+	}
+
+	return &t, nil
+}
+
+// parseExpiresAfter parses the expires_after attribute of a who-item into a number of seconds. Nil is returned if the attribute is not set.
+func parseExpiresAfter(value attr.Value) (*int, error) {
+	expiresAfter, ok := value.(types.String)
+	if !ok || expiresAfter.IsNull() || expiresAfter.IsUnknown() {
+		return nil, nil
+	}
+
+	duration, err := time.ParseDuration(expiresAfter.ValueString())
+	if err != nil || duration < time.Second {
+		return nil, fmt.Errorf("%q is not a valid duration of at least one second. Use a Go duration like 12h or 168h", expiresAfter.ValueString())
+	}
+
+	seconds := int(duration / time.Second)
+
+	return &seconds, nil
+}
+
+// whoItemExpired indicates that the expires_at timestamp of the who-item has passed.
+func whoItemExpired(attributes map[string]attr.Value, now time.Time) bool {
+	expiresAt, err := parseExpiresAt(attributes["expires_at"])
+
+	return err == nil && expiresAt != nil && !expiresAt.After(now)
+}
+
+// whoItemMayHaveExpired indicates that a who-item could have been removed from Raito Cloud because it expired.
+// For expires_after, recordedExpiry is the expiry timestamp Raito Cloud computed while the who-item still existed.
+// Without a recorded expiry, the who-item is assumed not to have expired.
+func whoItemMayHaveExpired(attributes map[string]attr.Value, recordedExpiry *time.Time, now time.Time) bool {
+	if whoItemExpired(attributes, now) {
+		return true
+	}
+
+	expiresAfter, found := attributes["expires_after"]
+
+	return found && !expiresAfter.IsNull() && recordedExpiry != nil && !recordedExpiry.After(now)
+}
+
+// whoItemExpiry returns the expires_at and expires_after attributes of a who-item read from Raito Cloud.
+// The values of the state are kept if they are equivalent with the values in Raito Cloud, to prevent formatting differences from causing a diff.
+func whoItemExpiry(stateAttributes map[string]attr.Value, item *raitoType.AccessProviderWhoListItem) (expiresAt types.String, expiresAfter types.String) {
+	expiresAt = types.StringNull()
+	expiresAfter = types.StringNull()
+
+	stateExpiresAfter, _ := parseExpiresAfter(stateAttributes["expires_after"])
+	if stateExpiresAfter != nil && (item.ExpiresAfter == nil || *item.ExpiresAfter == *stateExpiresAfter) {
+		// Raito Cloud computes the expiry timestamp for who-items with expires_after, which should not be reported as expires_at.
+		return expiresAt, stateAttributes["expires_after"].(types.String)
+	}
+
+	if item.ExpiresAfter != nil {
+		expiresAfter = types.StringValue((time.Duration(*item.ExpiresAfter) * time.Second).String())
+	}
+
+	if item.ExpiresAt != nil {
+		stateExpiresAt, _ := parseExpiresAt(stateAttributes["expires_at"])
+		if stateExpiresAt != nil && stateExpiresAt.Equal(*item.ExpiresAt) {
+			expiresAt = stateAttributes["expires_at"].(types.String)
+		} else {
+			expiresAt = types.StringValue(item.ExpiresAt.Format(time.RFC3339))
+		}
+	}
+
+	return expiresAt, expiresAfter
+}
+
+// withoutExpiredWhoItems removes the who-items with expires_after that were already applied, are no longer present in Raito Cloud
+// and of which the recorded expiry timestamp has passed. Those who-items expired and should not be granted again.
+func withoutExpiredWhoItems(who types.Set, priorWho types.Set, currentKeys set.Set[string], recordedExpiries whoItemExpiries, now time.Time) (types.Set, diag.Diagnostics) {
+	if who.IsNull() || who.IsUnknown() || priorWho.IsNull() || priorWho.IsUnknown() {
+		return who, nil
+	}
+
+	priorWhoItems := priorWho.Elements()
+	whoItems := make([]attr.Value, 0, len(who.Elements()))
+
+	for _, whoItem := range who.Elements() {
+		attributes := whoItem.(types.Object).Attributes()
+
+		if expiresAfter, found := attributes["expires_after"]; found && !expiresAfter.IsNull() {
+			key, ok := whoItemKey(attributes)
+
+			expiry, recorded := recordedExpiries[key]
+
+			if ok && recorded && !expiry.After(now) && !currentKeys.Contains(key) && containsValue(priorWhoItems, whoItem) {
+				continue
+			}
+		}
+
+		whoItems = append(whoItems, whoItem)
+	}
+
+	return types.SetValue(types.ObjectType{AttrTypes: whoItemAttributeTypes}, whoItems)
+}
+
+func containsValue(values []attr.Value, value attr.Value) bool {
+	for _, v := range values {
+		if v.Equal(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateWhoItemExpiry validates the expires_at and expires_after attributes of a who-item.
+func validateWhoItemExpiry(attributes map[string]attr.Value) (diagnostics diag.Diagnostics) {
+	expiresAt, err := parseExpiresAt(attributes["expires_at"])
+	if err != nil {
+		diagnostics.AddError("Invalid expires_at", err.Error())
+	}
+
+	expiresAfter, err := parseExpiresAfter(attributes["expires_after"])
+	if err != nil {
+		diagnostics.AddError("Invalid expires_after", err.Error())
+	}
+
+	if expiresAt != nil && expiresAfter != nil {
+		diagnostics.AddError("Invalid who-item expiry", "Only one of expires_at or expires_after can be set on a who-item.")
+	}
+
+	return diagnostics
+}
+
+// whoItemsWithExpiresAfter indicates that at least one of the who-items has expires_after set.
+func whoItemsWithExpiresAfter(who types.Set) bool {
+	for _, whoItem := range who.Elements() {
+		if expiresAfter, found := whoItem.(types.Object).Attributes()["expires_after"]; found && !expiresAfter.IsNull() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,205 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/raito-io/golang-set/set"
+	raitoType "github.com/raito-io/sdk-go/types"
+)
+
+func testWhoItem(user string, expiresAt string, expiresAfter string) types.Object {
+	stringOrNull := func(value string) types.String {
+		if value == "" {
+			return types.StringNull()
+		}
+
+		return types.StringValue(value)
+	}
+
+	return types.ObjectValueMust(whoItemAttributeTypes, map[string]attr.Value{
+		"user":             types.StringValue(user),
+		"group":            types.StringNull(),
+		"access_control":   types.StringNull(),
+		"promise_duration": types.Int64Null(),
+		"expires_at":       stringOrNull(expiresAt),
+		"expires_after":    stringOrNull(expiresAfter),
+	})
+}
+
+func TestWhoItemExpired(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name           string
+		whoItem        types.Object
+		recordedExpiry *time.Time
+		expired        bool
+		mayHaveExpired bool
+	}{
+		{name: "no expiry", whoItem: testWhoItem("a@raito.io", "", "")},
+		{name: "expires in the future", whoItem: testWhoItem("a@raito.io", "2025-01-02T00:00:00Z", "")},
+		{name: "expired", whoItem: testWhoItem("a@raito.io", "2025-01-01T11:00:00Z", ""), expired: true, mayHaveExpired: true},
+		{name: "expired in other timezone", whoItem: testWhoItem("a@raito.io", "2025-01-01T12:30:00+01:00", ""), expired: true, mayHaveExpired: true},
+		{name: "expires after without recorded expiry", whoItem: testWhoItem("a@raito.io", "", "24h")},
+		{name: "expires after with recorded expiry in the future", whoItem: testWhoItem("a@raito.io", "", "24h"), recordedExpiry: &future},
+		{name: "expires after with passed recorded expiry", whoItem: testWhoItem("a@raito.io", "", "24h"), recordedExpiry: &past, mayHaveExpired: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes := tt.whoItem.Attributes()
+
+			if got := whoItemExpired(attributes, now); got != tt.expired {
+				t.Errorf("whoItemExpired() = %t, want %t", got, tt.expired)
+			}
+
+			if got := whoItemMayHaveExpired(attributes, tt.recordedExpiry, now); got != tt.mayHaveExpired {
+				t.Errorf("whoItemMayHaveExpired() = %t, want %t", got, tt.mayHaveExpired)
+			}
+		})
+	}
+}
+
+func TestWhoItemExpiry(t *testing.T) {
+	expiresAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	expiresAfter := 86400
+
+	tests := []struct {
+		name             string
+		stateWhoItem     types.Object
+		item             raitoType.AccessProviderWhoListItem
+		wantExpiresAt    types.String
+		wantExpiresAfter types.String
+	}{
+		{
+			name:             "equivalent timestamp keeps state format",
+			stateWhoItem:     testWhoItem("a@raito.io", "2025-01-01T13:00:00+01:00", ""),
+			item:             raitoType.AccessProviderWhoListItem{ExpiresAt: &expiresAt},
+			wantExpiresAt:    types.StringValue("2025-01-01T13:00:00+01:00"),
+			wantExpiresAfter: types.StringNull(),
+		},
+		{
+			name:             "changed timestamp",
+			stateWhoItem:     testWhoItem("a@raito.io", "2025-02-01T12:00:00Z", ""),
+			item:             raitoType.AccessProviderWhoListItem{ExpiresAt: &expiresAt},
+			wantExpiresAt:    types.StringValue("2025-01-01T12:00:00Z"),
+			wantExpiresAfter: types.StringNull(),
+		},
+		{
+			name:             "expires after ignores computed timestamp",
+			stateWhoItem:     testWhoItem("a@raito.io", "", "24h"),
+			item:             raitoType.AccessProviderWhoListItem{ExpiresAt: &expiresAt, ExpiresAfter: &expiresAfter},
+			wantExpiresAt:    types.StringNull(),
+			wantExpiresAfter: types.StringValue("24h"),
+		},
+		{
+			name:             "expires after not in state",
+			stateWhoItem:     testWhoItem("a@raito.io", "", ""),
+			item:             raitoType.AccessProviderWhoListItem{ExpiresAfter: &expiresAfter},
+			wantExpiresAt:    types.StringNull(),
+			wantExpiresAfter: types.StringValue("24h0m0s"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotExpiresAt, gotExpiresAfter := whoItemExpiry(tt.stateWhoItem.Attributes(), &tt.item)
+
+			if !gotExpiresAt.Equal(tt.wantExpiresAt) {
+				t.Errorf("expires_at = %s, want %s", gotExpiresAt, tt.wantExpiresAt)
+			}
+
+			if !gotExpiresAfter.Equal(tt.wantExpiresAfter) {
+				t.Errorf("expires_after = %s, want %s", gotExpiresAfter, tt.wantExpiresAfter)
+			}
+		})
+	}
+}
+
+func TestWithoutExpiredWhoItems(t *testing.T) {
+	whoSetType := types.ObjectType{AttrTypes: whoItemAttributeTypes}
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	expired := testWhoItem("expired@raito.io", "", "24h")
+	removed := testWhoItem("removed@raito.io", "", "24h")
+	unrecorded := testWhoItem("unrecorded@raito.io", "", "24h")
+	active := testWhoItem("active@raito.io", "", "24h")
+	added := testWhoItem("added@raito.io", "", "24h")
+
+	recordedExpiries := whoItemExpiries{
+		_userPrefix("expired@raito.io"): now.Add(-time.Hour),
+		_userPrefix("removed@raito.io"): now.Add(time.Hour),
+		_userPrefix("active@raito.io"):  now.Add(-time.Hour),
+	}
+
+	prior := types.SetValueMust(whoSetType, []attr.Value{expired, removed, unrecorded, active})
+	planned := types.SetValueMust(whoSetType, []attr.Value{expired, removed, unrecorded, active, added})
+
+	got, diagnostics := withoutExpiredWhoItems(planned, prior, set.NewSet(_userPrefix("active@raito.io")), recordedExpiries, now)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", diagnostics)
+	}
+
+	want := types.SetValueMust(whoSetType, []attr.Value{removed, unrecorded, active, added})
+	if !got.Equal(want) {
+		t.Errorf("withoutExpiredWhoItems() = %s, want %s", got, want)
+	}
+}
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+	} else {
+		p[key] = value
+	}
+
+	return nil
+}
+
+func TestWhoItemExpiries_PrivateState(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+
+	expiries, diagnostics := getWhoItemExpiries(ctx, private)
+	if diagnostics.HasError() || len(expiries) != 0 {
+		t.Fatalf("getWhoItemExpiries() on empty private state = %v, %v", expiries, diagnostics)
+	}
+
+	want := whoItemExpiries{_userPrefix("a@raito.io"): time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)}
+
+	if diagnostics = setWhoItemExpiries(ctx, private, want); diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", diagnostics)
+	}
+
+	got, diagnostics := getWhoItemExpiries(ctx, private)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", diagnostics)
+	}
+
+	if len(got) != 1 || !got[_userPrefix("a@raito.io")].Equal(want[_userPrefix("a@raito.io")]) {
+		t.Errorf("getWhoItemExpiries() = %v, want %v", got, want)
+	}
+
+	if diagnostics = setWhoItemExpiries(ctx, private, whoItemExpiries{}); diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", diagnostics)
+	}
+
+	if _, found := private[whoItemExpiriesKey]; found {
+		t.Errorf("expected empty expiries to be removed from the private state")
+	}
+}
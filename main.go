@@ -3,11 +3,14 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
 	"github.com/raito-io/terraform-provider-raito/internal"
+	"github.com/raito-io/terraform-provider-raito/internal/cli"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -30,6 +33,15 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == cli.AbacEvaluateCommand {
+		if err := cli.AbacEvaluate(os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")